@@ -0,0 +1,35 @@
+//-----------------------------------------------------------------------------
+// FILE:		clients.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+// ClientStatus is the admin server's JSON view of one connected
+// Neon.Temporal client's ClientHelper, returned by GET /clients.
+type ClientStatus struct {
+	ClientID  int64  `json:"clientId"`
+	HostPort  string `json:"hostPort"`
+	Namespace string `json:"namespace"`
+	Connected bool   `json:"connected"`
+}
+
+// ClientsFunc is called by the admin server's GET /clients endpoint to
+// list the currently connected clients. It's a func rather than a
+// direct dependency on the handlers package's Clients map so that admin,
+// which handlers already imports, doesn't import handlers back --
+// whatever wires up the admin Server should set this to a closure over
+// its own Clients map.
+type ClientsFunc func() []ClientStatus
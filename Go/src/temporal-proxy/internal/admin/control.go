@@ -0,0 +1,301 @@
+//-----------------------------------------------------------------------------
+// FILE:		control.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package admin implements a runtime control plane for the temporal-proxy,
+// letting an external driver (typically an integration test harness) toggle
+// ForceReplay, drain workers, and inject faults into reply dispatch without
+// restarting the process. See ControlState and Server.
+package admin
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+const (
+
+	// _forceReplayGlobalKey is the map key ShouldForceReplay and
+	// SetForceReplay use to store the global (not workflow-type-scoped)
+	// ForceReplay override.
+	_forceReplayGlobalKey = ""
+)
+
+type (
+
+	// FaultPolicy describes how reply dispatch for a given message type
+	// should be perturbed for testing. A zero-value FaultPolicy injects
+	// no fault.
+	FaultPolicy struct {
+
+		// DropPercent is the percentage, 0-100, of matching replies that
+		// should be settled with a synthetic transient error, simulating
+		// a dropped connection to the Neon.Temporal client.
+		DropPercent float64
+
+		// DelayPercent is the percentage, 0-100, of matching replies that
+		// should be delayed by Delay before settling.
+		DelayPercent float64
+
+		// Delay is the amount of time to hold a reply before settling it
+		// when DelayPercent selects it.
+		Delay time.Duration
+
+		// ErrorPercent is the percentage, 0-100, of matching replies whose
+		// error should be overridden with ErrorMessage.
+		ErrorPercent float64
+
+		// ErrorMessage is the error text injected when ErrorPercent
+		// selects a reply.
+		ErrorMessage string
+	}
+
+	// ControlState is a thread-safe, process-wide singleton consulted by
+	// the reply handlers before they settle a reply, letting an external
+	// driver reconfigure the proxy's behavior at runtime via Server. The
+	// package-level State is the instance handlers should consult.
+	ControlState struct {
+		sync.Mutex
+		draining             bool
+		terminate            bool
+		replyAddress         string
+		forceReplayWorkflows map[string]bool
+		faultPolicies        map[string]FaultPolicy
+	}
+
+	// ConfigSnapshot is the JSON-serializable view of a ControlState's
+	// replyAddress/terminate flags returned by the admin server's GET
+	// /config endpoint. It mirrors the corresponding fields the proxy's
+	// own Instance carries, rather than owning them -- SetReplyAddress/
+	// SetTerminate are meant to be called from wherever that Instance
+	// sets them so /config stays truthful.
+	ConfigSnapshot struct {
+		ReplyAddress string `json:"replyAddress"`
+		Terminate    bool   `json:"terminate"`
+	}
+
+	// StateSnapshot is the JSON-serializable view of a ControlState
+	// returned by the admin server's GET /state endpoint.
+	StateSnapshot struct {
+		Draining             bool                   `json:"draining"`
+		ForceReplayWorkflows map[string]bool        `json:"forceReplayWorkflows"`
+		FaultPolicies        map[string]FaultPolicy `json:"faultPolicies"`
+	}
+)
+
+// State is the process-wide ControlState consulted by the reply handlers.
+var State = NewControlState()
+
+// NewControlState is the default constructor for a ControlState.
+//
+// returns *ControlState -> a pointer to a new ControlState in memory.
+func NewControlState() *ControlState {
+	state := new(ControlState)
+	state.forceReplayWorkflows = make(map[string]bool)
+	state.faultPolicies = make(map[string]FaultPolicy)
+
+	return state
+}
+
+// SetForceReplay sets the ForceReplay override for workflowName. An empty
+// workflowName sets the global override applied regardless of workflow
+// type. This method is thread-safe.
+//
+// params:
+//	- workflowName string -> the workflow type to scope the override to,
+//	or "" for the global override.
+// 	- value bool -> whether matching WorkflowInvokeReply dispatches
+//	should be forced to replay.
+func (state *ControlState) SetForceReplay(workflowName string, value bool) {
+	state.Lock()
+	defer state.Unlock()
+	state.forceReplayWorkflows[workflowName] = value
+}
+
+// ShouldForceReplay reports whether workflowName should be forced to
+// replay, honoring the global override before the per-workflow-type one.
+// This method is thread-safe.
+//
+// param workflowName string -> the workflow type being settled.
+//
+// returns bool -> true if the reply should be forced to replay.
+func (state *ControlState) ShouldForceReplay(workflowName string) bool {
+	state.Lock()
+	defer state.Unlock()
+	if state.forceReplayWorkflows[_forceReplayGlobalKey] {
+		return true
+	}
+
+	return state.forceReplayWorkflows[workflowName]
+}
+
+// SetFaultPolicy sets the FaultPolicy applied to reply dispatches of
+// messageType (e.g. "WorkflowInvokeReply"). This method is thread-safe.
+//
+// params:
+//	- messageType string -> the reply message type to scope the policy to.
+// 	- policy FaultPolicy -> the fault policy to apply.
+func (state *ControlState) SetFaultPolicy(messageType string, policy FaultPolicy) {
+	state.Lock()
+	defer state.Unlock()
+	state.faultPolicies[messageType] = policy
+}
+
+// ClearFaultPolicy removes any FaultPolicy configured for messageType.
+// This method is thread-safe.
+//
+// param messageType string -> the reply message type to clear.
+func (state *ControlState) ClearFaultPolicy(messageType string) {
+	state.Lock()
+	defer state.Unlock()
+	delete(state.faultPolicies, messageType)
+}
+
+// ApplyFault perturbs result/err for a reply of messageType according to
+// the FaultPolicy configured for that type, if any, simulating a dropped
+// connection, a delayed reply, or an injected application error. This
+// method is thread-safe.
+//
+// params:
+//	- messageType string -> the reply message type being settled.
+// 	- result interface{} -> the result about to be settled.
+// 	- err error -> the error about to be settled.
+//
+// returns:
+//	- interface{} -> the (possibly unchanged) result to settle.
+// 	- error -> the (possibly unchanged) error to settle.
+func (state *ControlState) ApplyFault(messageType string, result interface{}, err error) (interface{}, error) {
+	state.Lock()
+	policy, ok := state.faultPolicies[messageType]
+	state.Unlock()
+	if !ok {
+		return result, err
+	}
+
+	if policy.DelayPercent > 0 && rand.Float64()*100 < policy.DelayPercent {
+		time.Sleep(policy.Delay)
+	}
+
+	if policy.DropPercent > 0 && rand.Float64()*100 < policy.DropPercent {
+		return nil, errUnavailableFault
+	}
+
+	if policy.ErrorPercent > 0 && rand.Float64()*100 < policy.ErrorPercent {
+		return nil, &injectedFaultError{message: policy.ErrorMessage}
+	}
+
+	return result, err
+}
+
+// SetDraining sets whether the proxy is draining, letting callers that
+// consult IsDraining stop accepting new work ahead of a graceful
+// shutdown. This method is thread-safe.
+//
+// param value bool -> true to mark the proxy as draining.
+func (state *ControlState) SetDraining(value bool) {
+	state.Lock()
+	defer state.Unlock()
+	state.draining = value
+}
+
+// IsDraining reports whether the proxy is draining. This method is
+// thread-safe.
+//
+// returns bool -> true if the proxy is draining.
+func (state *ControlState) IsDraining() bool {
+	state.Lock()
+	defer state.Unlock()
+	return state.draining
+}
+
+// SetReplyAddress records the proxy's current replyAddress, the endpoint
+// its reply messages are being delivered to, so the admin server's
+// GET /config can report it. This method is thread-safe.
+//
+// param value string -> the replyAddress to record.
+func (state *ControlState) SetReplyAddress(value string) {
+	state.Lock()
+	defer state.Unlock()
+	state.replyAddress = value
+}
+
+// SetTerminate records whether the proxy has been told to terminate, so
+// the admin server's GET /config can report it. This method is
+// thread-safe.
+//
+// param value bool -> the terminate flag to record.
+func (state *ControlState) SetTerminate(value bool) {
+	state.Lock()
+	defer state.Unlock()
+	state.terminate = value
+}
+
+// ShouldTerminate reports whether the proxy has been told to terminate.
+// It's meant to be wired in as a transport.RetryOptions.ShouldStop hook,
+// so a transport stops retrying a send once SetTerminate(true) has been
+// called rather than continuing to retry into a client that's never
+// coming back. A TerminateRequest does not set this flag; it only
+// disconnects the requesting client's Session. This method is thread-safe.
+//
+// returns bool -> true if the proxy has been told to terminate.
+func (state *ControlState) ShouldTerminate() bool {
+	state.Lock()
+	defer state.Unlock()
+	return state.terminate
+}
+
+// Config returns a JSON-serializable copy of state's replyAddress and
+// terminate flags for the admin server's GET /config endpoint. This
+// method is thread-safe.
+//
+// returns ConfigSnapshot -> a copy of state's current replyAddress/
+// terminate flags.
+func (state *ControlState) Config() ConfigSnapshot {
+	state.Lock()
+	defer state.Unlock()
+
+	return ConfigSnapshot{
+		ReplyAddress: state.replyAddress,
+		Terminate:    state.terminate,
+	}
+}
+
+// Snapshot returns a JSON-serializable copy of state's current
+// configuration for the admin server's GET /state endpoint. This method
+// is thread-safe.
+//
+// returns StateSnapshot -> a copy of state's current configuration.
+func (state *ControlState) Snapshot() StateSnapshot {
+	state.Lock()
+	defer state.Unlock()
+
+	forceReplay := make(map[string]bool, len(state.forceReplayWorkflows))
+	for k, v := range state.forceReplayWorkflows {
+		forceReplay[k] = v
+	}
+
+	faultPolicies := make(map[string]FaultPolicy, len(state.faultPolicies))
+	for k, v := range state.faultPolicies {
+		faultPolicies[k] = v
+	}
+
+	return StateSnapshot{
+		Draining:             state.draining,
+		ForceReplayWorkflows: forceReplay,
+		FaultPolicies:        faultPolicies,
+	}
+}
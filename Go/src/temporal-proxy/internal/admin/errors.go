@@ -0,0 +1,37 @@
+//-----------------------------------------------------------------------------
+// FILE:		errors.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import "errors"
+
+// errUnavailableFault is the error a ControlState.ApplyFault injects when
+// a FaultPolicy's DropPercent selects a reply, worded to match the gRPC
+// Unavailable status code so it's picked up by handlers.IsTransientReplyError
+// the same way a genuine dropped connection would be.
+var errUnavailableFault = errors.New("Unavailable: simulated by admin fault policy")
+
+// injectedFaultError is the error a ControlState.ApplyFault injects when
+// a FaultPolicy's ErrorPercent selects a reply.
+type injectedFaultError struct {
+	message string
+}
+
+// Error implements the error interface.
+func (e *injectedFaultError) Error() string {
+	return e.message
+}
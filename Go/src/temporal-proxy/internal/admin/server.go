@@ -0,0 +1,278 @@
+//-----------------------------------------------------------------------------
+// FILE:		server.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+
+	"go.uber.org/zap"
+
+	"temporal-proxy/internal/metrics"
+)
+
+type (
+
+	// Server is an HTTP control/admin endpoint for a ControlState, meant
+	// to be started against a configurable listen address (typically
+	// passed through as a --control-listen-host-port flag) so an
+	// external driver, such as an integration test harness, can
+	// reconfigure a running temporal-proxy without restarting it.
+	Server struct {
+		state       *ControlState
+		messages    *MessageRing
+		clientsFunc ClientsFunc
+		mux         *http.ServeMux
+		httpServer  *http.Server
+		Logger      *zap.Logger
+
+		// Metrics is the Prometheus registry served at /metrics. The
+		// dispatch loop should record to it once that loop exists in
+		// this tree -- see the NOTE on package metrics.
+		Metrics *metrics.Registry
+	}
+
+	forceReplayCommand struct {
+		WorkflowName string `json:"workflowName"`
+		Value        bool   `json:"value"`
+	}
+
+	faultCommand struct {
+		MessageType string      `json:"messageType"`
+		Policy      FaultPolicy `json:"policy"`
+		Clear       bool        `json:"clear"`
+	}
+
+	drainCommand struct {
+		Value bool `json:"value"`
+	}
+)
+
+// NewServer is the default constructor for a Server. It wires its
+// endpoints against state but does not start listening; call
+// ListenAndServe to do that.
+//
+// params:
+//	- listenHostPort string -> the host:port the server should listen on.
+// 	- state *ControlState -> the ControlState the server reads from and
+//	mutates.
+// 	- logger *zap.Logger -> the logger the server logs to.
+//
+// returns *Server -> a pointer to a new Server in memory.
+func NewServer(listenHostPort string, state *ControlState, logger *zap.Logger) *Server {
+	server := &Server{
+		state:    state,
+		messages: Messages,
+		Metrics:  metrics.NewRegistry(),
+		Logger:   logger,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug", server.handleDebugIndex)
+	mux.Handle("/metrics", server.Metrics.Handler())
+	mux.HandleFunc("/state", server.handleState)
+	mux.HandleFunc("/force-replay", server.handleForceReplay)
+	mux.HandleFunc("/fault", server.handleFault)
+	mux.HandleFunc("/drain", server.handleDrain)
+	mux.HandleFunc("/config", server.handleConfig)
+	mux.HandleFunc("/messages", server.handleMessages)
+	mux.HandleFunc("/clients", server.handleClients)
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	server.mux = mux
+	server.httpServer = &http.Server{
+		Addr:    listenHostPort,
+		Handler: mux,
+	}
+
+	return server
+}
+
+// SetClientsFunc registers fn as the source GET /clients queries for the
+// currently connected Neon.Temporal clients. Until called, /clients
+// reports an empty list.
+//
+// param fn ClientsFunc -> a closure over the caller's own Clients map.
+func (server *Server) SetClientsFunc(fn ClientsFunc) {
+	server.clientsFunc = fn
+}
+
+// ListenAndServe starts the Server listening, blocking until it's shut
+// down via Shutdown or fails to bind.
+//
+// returns error -> any error returned by the underlying http.Server,
+// other than http.ErrServerClosed.
+func (server *Server) ListenAndServe() error {
+	err := server.httpServer.ListenAndServe()
+	if err == http.ErrServerClosed {
+		return nil
+	}
+
+	return err
+}
+
+// Shutdown gracefully stops the Server, waiting for in-flight requests
+// to complete.
+//
+// param ctx context.Context -> governs how long Shutdown waits for
+// in-flight requests before forcibly closing them.
+//
+// returns error -> any error returned by the underlying http.Server.
+func (server *Server) Shutdown(ctx context.Context) error {
+	return server.httpServer.Shutdown(ctx)
+}
+
+func (server *Server) handleState(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(server.state.Snapshot())
+}
+
+func (server *Server) handleForceReplay(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var command forceReplayCommand
+	if err := json.NewDecoder(r.Body).Decode(&command); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	server.state.SetForceReplay(command.WorkflowName, command.Value)
+	if server.Logger != nil {
+		server.Logger.Info("Admin: ForceReplay updated",
+			zap.String("WorkflowName", command.WorkflowName),
+			zap.Bool("Value", command.Value))
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) handleFault(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var command faultCommand
+	if err := json.NewDecoder(r.Body).Decode(&command); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if command.Clear {
+		server.state.ClearFaultPolicy(command.MessageType)
+	} else {
+		server.state.SetFaultPolicy(command.MessageType, command.Policy)
+	}
+
+	if server.Logger != nil {
+		server.Logger.Info("Admin: FaultPolicy updated",
+			zap.String("MessageType", command.MessageType),
+			zap.Bool("Clear", command.Clear))
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) handleDrain(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var command drainCommand
+	if err := json.NewDecoder(r.Body).Decode(&command); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	server.state.SetDraining(command.Value)
+	if server.Logger != nil {
+		server.Logger.Info("Admin: draining updated", zap.Bool("Value", command.Value))
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) handleConfig(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(server.state.Config())
+}
+
+func (server *Server) handleMessages(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(server.messages.Snapshot())
+}
+
+func (server *Server) handleClients(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	clients := []ClientStatus{}
+	if server.clientsFunc != nil {
+		clients = server.clientsFunc()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(clients)
+}
+
+// handleDebugIndex serves a minimal HTML index of the routes this
+// Server mounts, so an operator pointed at the admin listener has
+// somewhere to start without reading source.
+func (server *Server) handleDebugIndex(w http.ResponseWriter, r *http.Request) {
+	routes := []string{
+		"/state", "/config", "/clients", "/messages", "/metrics",
+		"/force-replay", "/fault", "/drain",
+		"/debug/pprof/", "/debug/pprof/cmdline", "/debug/pprof/profile",
+		"/debug/pprof/symbol", "/debug/pprof/trace",
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprintln(w, "<html><body><h1>temporal-proxy admin</h1><ul>")
+	for _, route := range routes {
+		fmt.Fprintf(w, "<li><a href=\"%s\">%s</a></li>\n", route, route)
+	}
+	fmt.Fprintln(w, "</ul></body></html>")
+}
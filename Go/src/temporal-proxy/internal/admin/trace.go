@@ -0,0 +1,104 @@
+//-----------------------------------------------------------------------------
+// FILE:		trace.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+
+	// MessageTrace records one ProxyMessage dispatched through the
+	// proxy's inbound dispatch loop, for the admin server's GET
+	// /messages endpoint to surface. It's deliberately independent of
+	// the zap logger -- an operator diagnosing a stuck workflow wants to
+	// query the last N dispatches by shape, not grep log lines.
+	MessageTrace struct {
+		Type      string        `json:"type"`
+		RequestID int64         `json:"requestId"`
+		Timestamp time.Time     `json:"timestamp"`
+		Latency   time.Duration `json:"latency"`
+		Error     string        `json:"error,omitempty"`
+	}
+
+	// MessageRing is a fixed-size, thread-safe ring buffer of the most
+	// recent MessageTraces. The package-level Messages is the instance
+	// the dispatch loop should record to once it exists in this tree --
+	// see the NOTE on Messages.
+	MessageRing struct {
+		mu    sync.Mutex
+		buf   []MessageTrace
+		next  int
+		count int
+	}
+)
+
+// Messages is the ring buffer the admin server's GET /messages endpoint
+// reads from.
+//
+// NOTE: the inbound dispatch loop this is meant to be fed from
+// (proccessIncomingMessage, per this request's own description) isn't
+// present in this snapshot of the repo -- only the leaf
+// handlers/messages/client packages are. Record is written against that
+// loop's description so it's ready to call once the loop exists; there
+// is deliberately no call site added here.
+var Messages = NewMessageRing(256)
+
+// NewMessageRing is the default constructor for a MessageRing.
+//
+// param size int -> the maximum number of MessageTraces to retain;
+// older traces are overwritten once size is reached.
+//
+// returns *MessageRing -> a pointer to a new MessageRing in memory.
+func NewMessageRing(size int) *MessageRing {
+	return &MessageRing{
+		buf: make([]MessageTrace, size),
+	}
+}
+
+// Record appends trace to the ring, overwriting the oldest entry once
+// the ring is full. This method is thread-safe.
+//
+// param trace MessageTrace -> the dispatch to record.
+func (ring *MessageRing) Record(trace MessageTrace) {
+	ring.mu.Lock()
+	defer ring.mu.Unlock()
+
+	ring.buf[ring.next] = trace
+	ring.next = (ring.next + 1) % len(ring.buf)
+	if ring.count < len(ring.buf) {
+		ring.count++
+	}
+}
+
+// Snapshot returns the retained MessageTraces in most-recent-first
+// order. This method is thread-safe.
+//
+// returns []MessageTrace -> the retained traces, newest first.
+func (ring *MessageRing) Snapshot() []MessageTrace {
+	ring.mu.Lock()
+	defer ring.mu.Unlock()
+
+	traces := make([]MessageTrace, ring.count)
+	for i := 0; i < ring.count; i++ {
+		traces[i] = ring.buf[(ring.next-1-i+len(ring.buf))%len(ring.buf)]
+	}
+
+	return traces
+}
@@ -0,0 +1,44 @@
+// -----------------------------------------------------------------------------
+// FILE:		activity_reply.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"os"
+
+	"go.uber.org/zap"
+
+	"temporal-proxy/internal/messages"
+)
+
+// -------------------------------------------------------------------------
+// Activity message types
+
+func handleActivityInvokeReply(reply *messages.ActivityInvokeReply, op *Operation) error {
+	requestID := reply.GetRequestID()
+	if ce := Logger.Check(zap.DebugLevel, "Settling Activity"); ce != nil {
+		ce.Write(
+			zap.Int64("ClientId", reply.GetClientID()),
+			zap.Int64("RequestId", requestID),
+			zap.Int("ProcessId", os.Getpid()))
+	}
+
+	// set the reply, retrying the round-trip with the Neon.Temporal
+	// client if err is a transient gRPC/transport failure rather than
+	// failing the activity outright
+	return settleReply("ActivityInvokeReply", op, reply.GetResult(), reply.GetError(), _defaultReplyRetryPolicy)
+}
@@ -0,0 +1,232 @@
+// -----------------------------------------------------------------------------
+// FILE:		activity_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"temporal-proxy/internal"
+	"temporal-proxy/internal/messages"
+)
+
+// ----------------------------------------------------------------------
+// IProxyRequest activity message type handler methods
+
+// activityHeadersContextKey is the workflow.Context value key
+// handleActivityExecuteRequest stashes an ActivityExecuteRequest's
+// Headers under before scheduling the activity, so activityFunc can
+// recover them on the way back out in its ActivityInvokeRequest.
+//
+// NOTE: a plain workflow.WithValue/ctx.Value round trip only carries a
+// value to other code sharing the same workflow goroutine -- it does
+// not, by itself, cross the real task boundary between the workflow
+// scheduling an activity and the worker that later executes it. Doing
+// that for real requires a workflow.ContextPropagator registered on both
+// the client.Options and worker.Options this proxy builds, which isn't
+// wired up in this snapshot. Until that exists, this key only lets
+// activityFunc see headers when the scheduling workflow and the executing
+// activity are, incidentally, running in the same process and goroutine
+// tree (e.g. local activities), not in the general case.
+type activityHeadersContextKeyType struct{}
+
+var activityHeadersContextKey = activityHeadersContextKeyType{}
+
+func handleActivityRegisterRequest(requestCtx context.Context, request *messages.ActivityRegisterRequest) messages.IProxyReply {
+	activityName := *request.GetName()
+	clientID := request.GetClientID()
+	workerID := request.GetWorkerID()
+	Logger.Debug("ActivityRegisterRequest Received",
+		zap.String("Activity", activityName),
+		zap.Int64("WorkerId", workerID),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new ActivityRegisterReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the activity function.  Unlike a workflow, an activity has no
+	// long-lived Context to park in WorkflowContexts -- it runs once and
+	// the proxy only needs to forward its input to the Neon.Temporal
+	// client and hand back whatever result (or error) comes back.
+	activityFunc := func(ctx context.Context, input []byte) ([]byte, error) {
+		requestID := clientHelper.NextRequestID()
+		activityInfo := activity.GetInfo(ctx)
+		Logger.Debug("Executing Activity",
+			zap.String("Activity", activityName),
+			zap.Int64("ClientId", clientID),
+			zap.Int64("WorkerId", workerID),
+			zap.Int64("RequestId", requestID),
+			zap.String("TaskToken", string(activityInfo.TaskToken)),
+			zap.Int("ProcessId", os.Getpid()))
+
+		// send an ActivityInvokeRequest to the Neon.Temporal Lib
+		// temporal-client
+		invokeRequest := messages.NewActivityInvokeRequest()
+		invokeRequest.SetRequestID(requestID)
+		invokeRequest.SetClientID(clientID)
+		invokeRequest.SetWorkerID(workerID)
+		invokeRequest.SetActivity(&activityName)
+		invokeRequest.SetArgs(input)
+		if !internal.DisableHeaderForwarding {
+			if headers, ok := ctx.Value(activityHeadersContextKey).(map[string][]byte); ok {
+				invokeRequest.SetHeaders(headers)
+			}
+		}
+
+		// create the Operation for this request and add it to the
+		// operations map
+		op := NewOperation(requestID, invokeRequest)
+		op.SetChannel(make(chan interface{}))
+		op.SetClientID(clientID)
+		clientHelper.Operations.Add(requestID, op)
+		putOperationRecord(clientID, op)
+
+		// send invokeRequest
+		go sendMessage(invokeRequest)
+
+		Logger.Debug("ActivityInvokeRequest sent",
+			zap.String("Activity", activityName),
+			zap.Int64("ClientId", clientID),
+			zap.Int64("WorkerId", workerID),
+			zap.Int64("RequestId", requestID),
+			zap.Int("ProcessId", os.Getpid()))
+
+		// block and get result
+		result := <-op.GetChannel()
+		switch s := result.(type) {
+		case error:
+			Logger.Error("Activity Failed With Error",
+				zap.String("Activity", activityName),
+				zap.Int64("ClientId", clientID),
+				zap.Int64("WorkerId", workerID),
+				zap.Int64("RequestId", requestID),
+				zap.Error(s),
+				zap.Int("ProcessId", os.Getpid()))
+
+			return nil, s
+
+		case []byte:
+			Logger.Info("Activity Completed Successfully",
+				zap.String("Activity", activityName),
+				zap.Int64("ClientId", clientID),
+				zap.Int64("WorkerId", workerID),
+				zap.Int64("RequestId", requestID),
+				zap.ByteString("Result", s),
+				zap.Int("ProcessId", os.Getpid()))
+
+			return s, nil
+
+		default:
+			Logger.Error("Unexpected result type",
+				zap.String("Activity", activityName),
+				zap.Int64("ClientId", clientID),
+				zap.Int64("WorkerId", workerID),
+				zap.Int64("RequestId", requestID),
+				zap.Any("Result", s),
+				zap.Int("ProcessId", os.Getpid()))
+
+			return nil, fmt.Errorf("unexpected result type %v.  result must be an error or []byte", reflect.TypeOf(s))
+		}
+	}
+
+	clientHelper.ActivityRegister(workerID, activityFunc, activityName)
+	Logger.Debug("activity successfully registered", zap.String("Activity", activityName))
+	reply.Build(nil)
+
+	return reply
+}
+
+func handleActivityExecuteRequest(requestCtx context.Context, request *messages.ActivityExecuteRequest) messages.IProxyReply {
+	contextID := request.GetContextID()
+	clientID := request.GetClientID()
+	requestID := request.GetRequestID()
+	activityName := *request.GetActivity()
+	Logger.Debug("ActivityExecuteRequest Received",
+		zap.String("Activity", activityName),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("ContextId", contextID),
+		zap.Int64("RequestId", requestID),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new ActivityExecuteReply
+	reply := messages.CreateReplyMessage(request)
+
+	// get the parent workflow's context, since an activity is always
+	// scheduled from within an executing workflow
+	wectx := WorkflowContexts.Get(contextID)
+	if wectx == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	ctx := wectx.GetContext()
+
+	// set options on the context
+	var opts workflow.ActivityOptions
+	if v := request.GetOptions(); v != nil {
+		opts = *v
+	}
+	ctx = workflow.WithActivityOptions(ctx, opts)
+
+	// forward the caller's headers onto ctx so activityFunc can recover
+	// them via activityHeadersContextKey (see its NOTE for the current
+	// limits of this without a registered ContextPropagator)
+	if !internal.DisableHeaderForwarding {
+		if headers := request.GetHeaders(); len(headers) > 0 {
+			ctx = workflow.WithValue(ctx, activityHeadersContextKey, headers)
+		}
+	}
+
+	// execute the activity and block for its result.  Unlike child
+	// workflows, activities don't have a separate wait-for-result
+	// request -- ActivityExecuteRequest returns the activity's result
+	// directly once it completes.
+	var result []byte
+	future := workflow.ExecuteActivity(ctx, activityName, request.GetArgs())
+	if err := future.Get(ctx, &result); err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	reply.Build(nil, result)
+
+	return reply
+}
+
+func init() {
+	RegisterHandler(internal.ActivityExecuteRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleActivityExecuteRequest(requestCtx, request.(*messages.ActivityExecuteRequest))
+	})
+	RegisterHandler(internal.ActivityRegisterRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleActivityRegisterRequest(requestCtx, request.(*messages.ActivityRegisterRequest))
+	})
+}
@@ -0,0 +1,72 @@
+//-----------------------------------------------------------------------------
+// FILE:		context_store.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"temporal-proxy/internal/store"
+)
+
+// Contexts is the ContextStore WorkflowContexts bookkeeping is mirrored
+// into, so a restarted proxy can tell which
+// ContextIDs were still open when it went down. It defaults to an
+// in-memory store (the same restart-unsafe behavior as before this
+// existed); set it to an etcd-backed store.NewContextStore(StorageConfig)
+// result at startup to make that bookkeeping durable.
+var Contexts store.ContextStore = store.NewMemoryContextStore()
+
+// RehydrateContexts lists every ContextRecord Contexts has persisted for
+// clientID, logging each one found. WorkflowContexts entries themselves
+// can't be rehydrated from these records -- a workflow.Context
+// and its Operation's Go channel are process-local and don't survive a
+// restart -- but Temporal's own task retry will redeliver the workflow
+// task and recreate the context under a new ContextID regardless. What
+// this buys the operator is visibility: every record still present here
+// after a restart is work the proxy never got an answer to, which
+// RehydrateContexts deletes from Contexts once logged so the store
+// doesn't accumulate them forever.
+//
+// param clientID int64 -> the client instance to rehydrate bookkeeping for.
+//
+// returns error -> an error, if one occurred listing or clearing records.
+func RehydrateContexts(clientID int64) error {
+	ctx := context.Background()
+
+	records, err := Contexts.List(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		Logger.Warn("Orphaned context found on startup",
+			zap.Int64("ClientId", record.ClientID),
+			zap.Int64("ContextId", record.ContextID),
+			zap.Int64("RequestId", record.RequestID),
+			zap.String("Workflow", record.WorkflowName),
+			zap.String("Namespace", record.Namespace))
+
+		if err := Contexts.Delete(ctx, record.ClientID, record.ContextID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// -----------------------------------------------------------------------------
+// FILE:		dispatch.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"context"
+	"sync"
+
+	"temporal-proxy/internal"
+	"temporal-proxy/internal/messages"
+)
+
+// MessageHandler handles a single decoded IProxyRequest and returns the
+// IProxyReply to send back to its caller, matching the signature every
+// handleXxxRequest function in this package already has.
+type MessageHandler func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply
+
+// HandlerMiddleware wraps a MessageHandler with a cross-cutting concern
+// -- metrics, tracing, error reporting, panic recovery -- so that
+// concern is applied once, uniformly, rather than copy-pasted into every
+// handleXxxRequest. See RecoverMiddleware for the one this package
+// registers by default.
+type HandlerMiddleware func(next MessageHandler) MessageHandler
+
+var (
+	registryMu  sync.RWMutex
+	registry    = make(map[internal.MessageType]MessageHandler)
+	middlewares []HandlerMiddleware
+)
+
+// RegisterHandler registers handler as the MessageHandler for msgType,
+// replacing any handler previously registered for it. Each message type
+// registers its own handler from an init() function in its own file
+// (e.g. ActivityExecuteRequest in activity_request.go), so adding a new
+// message type to the dispatcher never means editing this file or a
+// central switch.
+func RegisterHandler(msgType internal.MessageType, handler MessageHandler) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	registry[msgType] = handler
+}
+
+// Use appends mw to the middleware chain Dispatch wraps every handler
+// in. Middleware registered earlier is outermost -- the first Use call
+// sees the request first and the reply last.
+func Use(mw HandlerMiddleware) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	middlewares = append(middlewares, mw)
+}
+
+// unknownMessageHandler is the MessageHandler Dispatch falls back to
+// when request's MessageType has nothing registered for it.
+func unknownMessageHandler(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+	reply := messages.CreateReplyMessage(request)
+	reply.Build(internal.ErrEntityNotExist)
+
+	return reply
+}
+
+// Dispatch looks up request's MessageType in the handler registry and
+// invokes it, wrapped in every middleware registered with Use, returning
+// an internal.ErrEntityNotExist reply instead of panicking if this
+// proxy build has no handler registered for that MessageType.
+//
+// params:
+//	- requestCtx context.Context -> the context for the request.
+// 	- request messages.IProxyRequest -> the decoded request to route.
+//
+// returns messages.IProxyReply -> the reply returned by the matched
+// handler (or unknownMessageHandler), after every middleware has run.
+func Dispatch(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+	registryMu.RLock()
+	handler, ok := registry[request.GetType()]
+	chain := make([]HandlerMiddleware, len(middlewares))
+	copy(chain, middlewares)
+	registryMu.RUnlock()
+
+	if !ok {
+		handler = unknownMessageHandler
+	}
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		handler = chain[i](handler)
+	}
+
+	return handler(requestCtx, request)
+}
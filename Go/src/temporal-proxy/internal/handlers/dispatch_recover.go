@@ -0,0 +1,59 @@
+// -----------------------------------------------------------------------------
+// FILE:		dispatch_recover.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"temporal-proxy/internal"
+	"temporal-proxy/internal/messages"
+)
+
+func init() {
+	Use(RecoverMiddleware)
+}
+
+// RecoverMiddleware is the HandlerMiddleware this package registers by
+// default, applied outermost to every handler Dispatch invokes. It turns
+// a panic inside next into an ErrEntityNotExist reply and a
+// reportHandlerError call, rather than the panic unwinding into whatever
+// transport called Dispatch and taking the whole proxy process down
+// with it.
+func RecoverMiddleware(next MessageHandler) MessageHandler {
+	return func(requestCtx context.Context, request messages.IProxyRequest) (reply messages.IProxyReply) {
+		defer func() {
+			if r := recover(); r != nil {
+				err := fmt.Errorf("panic in handler: %v", r)
+
+				Logger.Error("Recovered panic in message handler",
+					zap.Int64("RequestId", request.GetRequestID()),
+					zap.Error(err))
+
+				reportHandlerError(requestCtx, fmt.Sprintf("%v", request.GetType()), request.GetRequestID(), err, nil)
+
+				reply = messages.CreateReplyMessage(request)
+				reply.Build(internal.ErrEntityNotExist)
+			}
+		}()
+
+		return next(requestCtx, request)
+	}
+}
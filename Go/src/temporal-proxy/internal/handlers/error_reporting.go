@@ -0,0 +1,57 @@
+// -----------------------------------------------------------------------------
+// FILE:		error_reporting.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"context"
+	"strconv"
+
+	"temporal-proxy/internal/reporting"
+)
+
+// reportHandlerError sends err to reporting.Default, tagged with
+// messageType and requestID plus anything in extra (e.g. "context_id",
+// "workflow_name"), so a real error-reporting backend -- Sentry,
+// OpenTelemetry, whatever reporting.SetDefault was given at startup --
+// sees it instead of it only ever reaching a Logger.Debug line. A nil
+// err is a no-op.
+//
+// params:
+//	- ctx context.Context -> the context the failing call ran under, for
+//	an OTelReporter to pull its span from.
+// 	- messageType string -> the message type the error occurred settling
+//	or handling (e.g. "WorkflowInvokeReply").
+// 	- requestID int64 -> the RequestID of the request/reply involved.
+// 	- err error -> the error to report; ignored if nil.
+// 	- extra map[string]string -> additional tags, e.g. "workflow_name" or
+//	"namespace" when the caller has them to hand.
+func reportHandlerError(ctx context.Context, messageType string, requestID int64, err error, extra map[string]string) {
+	if err == nil {
+		return
+	}
+
+	tags := map[string]string{
+		"message_type": messageType,
+		"request_id":   strconv.FormatInt(requestID, 10),
+	}
+	for k, v := range extra {
+		tags[k] = v
+	}
+
+	reporting.Default.Report(ctx, err, tags)
+}
@@ -0,0 +1,60 @@
+// -----------------------------------------------------------------------------
+// FILE:		log_level_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"temporal-proxy/internal"
+	"temporal-proxy/internal/messages"
+)
+
+// ----------------------------------------------------------------------
+// IProxyRequest log level message type handler methods
+
+func handleLogLevelRequest(requestCtx context.Context, request *messages.LogLevelRequest) messages.IProxyReply {
+	reply := messages.CreateReplyMessage(request)
+
+	levelPtr := request.GetLogLevel()
+	if levelPtr == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	var level zapcore.Level
+	if err := level.UnmarshalText([]byte(*levelPtr)); err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	internal.LogLevel.SetLevel(level)
+
+	Logger.Debug("Proxy log level changed", zap.String("Level", level.String()))
+
+	reply.Build(nil)
+	return reply
+}
+
+func init() {
+	RegisterHandler(internal.LogLevelRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleLogLevelRequest(requestCtx, request.(*messages.LogLevelRequest))
+	})
+}
@@ -0,0 +1,260 @@
+// -----------------------------------------------------------------------------
+// FILE:		operation.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"temporal-proxy/internal/messages"
+)
+
+// _sendChannelTimeout bounds how long SendChannel waits for a receiver
+// before giving up, so settling a reply for an Operation nobody is
+// listening on anymore (its caller already gave up, or it was reaped)
+// can't block the goroutine delivering it forever.
+const _sendChannelTimeout = 30 * time.Second
+
+// _defaultReapAge is how old an Operation can get, with no reply ever
+// arriving for it, before StartReaper treats it as orphaned.
+const _defaultReapAge = 2 * time.Minute
+
+// _defaultReapInterval is how often a Session sweeps its own
+// OperationRegistry for orphaned Operations (see NewSession).
+const _defaultReapInterval = 30 * time.Second
+
+type (
+
+	// Operation is the correlation/future for one in-flight request
+	// this proxy sent to the Neon.Temporal client: it pairs the
+	// original invoke request (resent on a transient-error retry, see
+	// settleReply) with the channel the caller is blocked reading from,
+	// so the eventual reply -- once dispatched to the matching
+	// handle*Reply by RequestID -- can deliver its result back across
+	// goroutines.
+	Operation struct {
+		requestID int64
+		contextID int64
+		clientID  int64
+		request   messages.IProxyRequest
+		channel   chan interface{}
+		createdAt time.Time
+	}
+
+	// OperationRegistry is the concurrent map of RequestID to Operation
+	// that each Session owns one of (see Session.Operations). A
+	// handle*Reply function resolves its Operation by looking it up
+	// here with the RequestID carried on the reply it was just
+	// dispatched.
+	OperationRegistry struct {
+		mu         sync.Mutex
+		operations map[int64]*Operation
+	}
+)
+
+// NewOperation is the default constructor for an Operation.
+//
+// params:
+//	- requestID int64 -> the RequestID of request, used to correlate the
+//	eventual reply back to this Operation.
+// 	- request messages.IProxyRequest -> the request originally sent to
+//	the Neon.Temporal client, kept so it can be resent on a transient
+//	retry.
+//
+// returns *Operation -> a pointer to a new Operation in memory.
+func NewOperation(requestID int64, request messages.IProxyRequest) *Operation {
+	return &Operation{
+		requestID: requestID,
+		request:   request,
+		createdAt: time.Now(),
+	}
+}
+
+// GetRequestID returns the RequestID this Operation is registered under.
+func (op *Operation) GetRequestID() int64 {
+	return op.requestID
+}
+
+// GetContextID returns the WorkflowContextID this Operation belongs to.
+func (op *Operation) GetContextID() int64 {
+	return op.contextID
+}
+
+// SetContextID sets the WorkflowContextID this Operation belongs to.
+func (op *Operation) SetContextID(value int64) {
+	op.contextID = value
+}
+
+// GetClientID returns the ClientID this Operation was sent on behalf of.
+func (op *Operation) GetClientID() int64 {
+	return op.clientID
+}
+
+// SetClientID sets the ClientID this Operation was sent on behalf of,
+// used to key its OperationRecord in OperationRecords.
+func (op *Operation) SetClientID(value int64) {
+	op.clientID = value
+}
+
+// GetRequest returns the request originally sent to the Neon.Temporal
+// client, resent on a transient-error retry by settleReply.
+func (op *Operation) GetRequest() messages.IProxyRequest {
+	return op.request
+}
+
+// SetChannel sets the channel the caller awaiting this Operation's
+// result is blocked reading from.
+func (op *Operation) SetChannel(channel chan interface{}) {
+	op.channel = channel
+}
+
+// GetChannel returns the channel the caller awaiting this Operation's
+// result is blocked reading from.
+func (op *Operation) GetChannel() chan interface{} {
+	return op.channel
+}
+
+// SendChannel delivers err to op's caller if non-nil, otherwise result,
+// unblocking whatever is reading op.GetChannel(). If nothing reads the
+// channel within _sendChannelTimeout -- the caller already timed out,
+// or this Operation was reaped as orphaned -- it gives up rather than
+// blocking the goroutine settling the reply forever.
+//
+// params:
+//	- result interface{} -> the result to deliver, if err is nil.
+// 	- err error -> the error to deliver, taking precedence over result.
+//
+// returns error -> a delivery error, not err itself; nil once result/err
+// have been handed to the channel.
+func (op *Operation) SendChannel(result interface{}, err error) error {
+	if op.channel == nil {
+		return errors.New("operation: no channel registered for this operation")
+	}
+
+	var value interface{} = result
+	if err != nil {
+		value = err
+	}
+
+	select {
+	case op.channel <- value:
+		return nil
+	case <-time.After(_sendChannelTimeout):
+		return fmt.Errorf("operation: timed out delivering reply for RequestId %d, no receiver", op.requestID)
+	}
+}
+
+// NewOperationRegistry is the default constructor for an
+// OperationRegistry.
+//
+// returns *OperationRegistry -> a pointer to a new OperationRegistry in
+// memory.
+func NewOperationRegistry() *OperationRegistry {
+	return &OperationRegistry{
+		operations: make(map[int64]*Operation),
+	}
+}
+
+// Add registers op under requestID, replacing any Operation previously
+// registered under the same RequestID.
+func (registry *OperationRegistry) Add(requestID int64, op *Operation) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.operations[requestID] = op
+}
+
+// Get returns the Operation registered under requestID, or nil if none
+// is.
+func (registry *OperationRegistry) Get(requestID int64) *Operation {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	return registry.operations[requestID]
+}
+
+// Remove deregisters the Operation at requestID, if any.
+func (registry *OperationRegistry) Remove(requestID int64) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	delete(registry.operations, requestID)
+}
+
+// Reap removes and fails every Operation registered longer ago than
+// maxAge, delivering a timeout error to each one's waiting caller so it
+// doesn't block forever on a reply that will never arrive -- the
+// Neon.Temporal client died, or the reply was dropped in flight.
+//
+// param maxAge time.Duration -> how old an unresolved Operation must be
+// to count as orphaned.
+//
+// returns int -> the number of Operations reaped.
+func (registry *OperationRegistry) Reap(maxAge time.Duration) int {
+	cutoff := time.Now().Add(-maxAge)
+
+	registry.mu.Lock()
+	var orphaned []*Operation
+	for requestID, op := range registry.operations {
+		if op.createdAt.Before(cutoff) {
+			orphaned = append(orphaned, op)
+			delete(registry.operations, requestID)
+		}
+	}
+	registry.mu.Unlock()
+
+	for _, op := range orphaned {
+		_ = op.SendChannel(nil, fmt.Errorf("operation: timed out waiting for a reply to RequestId %d", op.requestID))
+	}
+
+	return len(orphaned)
+}
+
+// StartReaper runs Reap against maxAge every interval until stop is
+// closed. NewSession launches one of these per Session, against that
+// Session's own OperationRegistry, so one client's orphaned Operations
+// are reaped independently of every other client's (see the NOTE on
+// package transport regarding the still-absent dispatch loop that will
+// eventually settle these Operations instead of leaving them to time out
+// here).
+//
+// params:
+//	- interval time.Duration -> how often to sweep for orphaned
+//	Operations.
+// 	- maxAge time.Duration -> how old an unresolved Operation must be to
+//	count as orphaned.
+// 	- stop <-chan struct{} -> closed to stop the reaper.
+func (registry *OperationRegistry) StartReaper(interval time.Duration, maxAge time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if n := registry.Reap(maxAge); n > 0 {
+				Logger.Warn("Reaped orphaned operations", zap.Int("Count", n))
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+//-----------------------------------------------------------------------------
+// FILE:		operation_store.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"temporal-proxy/internal/messages"
+	"temporal-proxy/internal/store"
+)
+
+// OperationRecords is the OperationStore every handle*Request function
+// mirrors its OperationRegistry.Add/settleReply's removal into, so an
+// in-flight request survives a proxy restart instead of leaving its
+// caller blocked on a channel nothing will ever deliver to again. It
+// defaults to an in-memory store (the same restart-unsafe behavior as
+// before this existed); set it to a store.NewOperationStore(StorageConfig)
+// result at startup -- typically the same StorageConfig passed to
+// Contexts -- to make that bookkeeping durable.
+var OperationRecords store.OperationStore = store.NewMemoryOperationStore()
+
+// putOperationRecord mirrors op into OperationRecords, logging rather
+// than failing the request if the durable write itself fails -- the
+// in-memory Operation this mirrors is still fully usable either way.
+func putOperationRecord(clientID int64, op *Operation) {
+	data, err := messages.Serialize(op.GetRequest())
+	if err != nil {
+		Logger.Warn("Failed to serialize operation for durable storage",
+			zap.Int64("ClientId", clientID),
+			zap.Int64("RequestId", op.GetRequestID()),
+			zap.Error(err))
+
+		return
+	}
+
+	record := store.OperationRecord{
+		ClientID:    clientID,
+		RequestID:   op.GetRequestID(),
+		ContextID:   op.GetContextID(),
+		RequestData: data,
+		CreatedAt:   time.Now(),
+	}
+
+	if err := OperationRecords.Put(context.Background(), record); err != nil {
+		Logger.Warn("Failed to durably persist operation",
+			zap.Int64("ClientId", clientID),
+			zap.Int64("RequestId", op.GetRequestID()),
+			zap.Error(err))
+	}
+}
+
+// RehydrateOperations lists every OperationRecord OperationRecords has
+// persisted for clientID, logging each one found as an Operation the
+// proxy never settled before it went down. Unlike ContextRecords, these
+// can't be resumed from here: resending RequestData requires the
+// request/reply dispatch loop's sendMessage, which isn't present in this
+// snapshot (see the NOTE on package transport). What RehydrateOperations
+// buys the operator today is the same visibility RehydrateContexts buys
+// for WorkflowContexts: every record still present here after a restart
+// is a request that never got an answer, which RehydrateOperations
+// deletes from OperationRecords once logged so the store doesn't
+// accumulate them forever.
+//
+// param clientID int64 -> the client instance to rehydrate bookkeeping for.
+//
+// returns error -> an error, if one occurred listing or clearing records.
+func RehydrateOperations(clientID int64) error {
+	ctx := context.Background()
+
+	records, err := OperationRecords.List(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		Logger.Warn("Orphaned operation found on startup",
+			zap.Int64("ClientId", record.ClientID),
+			zap.Int64("ContextId", record.ContextID),
+			zap.Int64("RequestId", record.RequestID))
+
+		if err := OperationRecords.Delete(ctx, record.ClientID, record.RequestID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
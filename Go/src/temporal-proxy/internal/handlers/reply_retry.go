@@ -0,0 +1,159 @@
+//-----------------------------------------------------------------------------
+// FILE:		reply_retry.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"temporal-proxy/internal"
+	"temporal-proxy/internal/admin"
+	proxyclient "temporal-proxy/internal/temporal/client"
+)
+
+const (
+
+	// _unavailableErrorStr is the message fragment gRPC uses for the
+	// Unavailable status code, returned when the Temporal frontend
+	// couldn't be reached.
+	_unavailableErrorStr = "Unavailable"
+
+	// _resourceExhaustedErrorStr is the message fragment gRPC uses for
+	// the ResourceExhausted status code, returned when the Temporal
+	// frontend is throttling the caller.
+	_resourceExhaustedErrorStr = "ResourceExhausted"
+
+	// _deadlineExceededErrorStr is the message fragment gRPC uses for
+	// the DeadlineExceeded status code, returned when a call to the
+	// Temporal frontend timed out in flight.
+	_deadlineExceededErrorStr = "DeadlineExceeded"
+)
+
+// _defaultReplyRetryPolicy is the RetryPolicy applied by settleReply when
+// a reply handler doesn't specify one of its own.
+var _defaultReplyRetryPolicy = proxyclient.RetryPolicy{
+	InitialInterval:    time.Second,
+	BackoffCoefficient: 2.0,
+	MaximumInterval:    time.Second * 30,
+	MaximumAttempts:    5,
+}
+
+// IsTransientReplyError classifies err, the error carried on a ProxyReply
+// received from the Neon.Temporal client, as a transient gRPC or
+// transport failure between the client and the Temporal frontend that's
+// likely to succeed if the underlying invoke request is simply sent
+// again, as opposed to an application-level failure that will never
+// succeed on retry (EntityNotExistsError, BadRequestError,
+// WorkflowExecutionAlreadyStartedError) or an intentional cancellation
+// that must be surfaced to the awaiting workflow unchanged.
+//
+// param err error -> the error carried on the reply being settled.
+//
+// returns bool -> true if err is transient and worth retrying.
+func IsTransientReplyError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if internal.IsCancelledError(err) {
+		return false
+	}
+
+	message := err.Error()
+	if strings.Contains(message, "NotExist") ||
+		strings.Contains(message, "InvalidArgument") ||
+		strings.Contains(message, "AlreadyExists") ||
+		strings.Contains(message, "BadRequest") {
+		return false
+	}
+
+	return strings.Contains(message, _unavailableErrorStr) ||
+		strings.Contains(message, _resourceExhaustedErrorStr) ||
+		strings.Contains(message, _deadlineExceededErrorStr)
+}
+
+// settleReply delivers result/err to op's awaiting caller via
+// op.SendChannel, first retrying the round-trip with the Neon.Temporal
+// client according to policy whenever err is transient (see
+// IsTransientReplyError), rather than failing the operation outright on
+// the first gRPC blip between the proxy and the Temporal frontend. Each
+// retry resends op's original invoke request and waits for a fresh
+// reply to arrive on the same operation before re-classifying the
+// error, up to policy's MaximumAttempts. Before any of that, result/err
+// are run through admin.State.ApplyFault so an integration test driving
+// the admin control endpoint can simulate a dropped, delayed, or failed
+// reply for messageType. A final non-nil err, once retries are
+// exhausted, is also sent to reportHandlerError so it's visible to
+// whatever ErrorReporter backend the proxy was started with, not just a
+// debug log line. Once op is settled -- successfully or not -- its
+// OperationRecord is removed from OperationRecords, since a reply no
+// longer pending doesn't need to survive a restart.
+//
+// params:
+//	- messageType string -> the reply message type being settled (e.g.
+//	"WorkflowInvokeReply"), used to look up an admin.FaultPolicy.
+// 	- op *Operation -> the in-flight operation to settle; its original
+// 	invoke request is resent on each retry.
+// 	- result interface{} -> the result to deliver once resolved.
+// 	- err error -> the error accompanying the reply being settled.
+// 	- policy proxyclient.RetryPolicy -> governs the backoff between
+// 	retries and the maximum number of attempts.
+//
+// returns error -> whatever op.SendChannel returns.
+func settleReply(messageType string, op *Operation, result interface{}, err error, policy proxyclient.RetryPolicy) error {
+	defer func() { _ = OperationRecords.Delete(context.Background(), op.GetClientID(), op.GetRequestID()) }()
+
+	result, err = admin.State.ApplyFault(messageType, result, err)
+
+	interval := policy.InitialInterval
+
+	for attempt := 1; IsTransientReplyError(err) && attempt < policy.MaximumAttempts; attempt++ {
+		Logger.Warn("Retrying transient reply error",
+			zap.Int64("ContextId", op.GetContextID()),
+			zap.Int("Attempt", attempt),
+			zap.Error(err))
+
+		time.Sleep(interval)
+
+		interval = time.Duration(float64(interval) * policy.BackoffCoefficient)
+		if interval > policy.MaximumInterval {
+			interval = policy.MaximumInterval
+		}
+
+		go sendMessage(op.GetRequest())
+
+		switch s := (<-op.GetChannel()).(type) {
+		case error:
+			result, err = nil, s
+		default:
+			result, err = s, nil
+		}
+	}
+
+	if err != nil {
+		reportHandlerError(context.Background(), messageType, op.GetRequestID(), err, map[string]string{
+			"context_id": strconv.FormatInt(op.GetContextID(), 10),
+		})
+	}
+
+	return op.SendChannel(result, err)
+}
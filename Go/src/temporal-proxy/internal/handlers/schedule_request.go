@@ -0,0 +1,394 @@
+// -----------------------------------------------------------------------------
+// FILE:		schedule_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"context"
+	"os"
+
+	"go.temporal.io/sdk/client"
+	"go.uber.org/zap"
+
+	"temporal-proxy/internal"
+	"temporal-proxy/internal/messages"
+)
+
+// ----------------------------------------------------------------------
+// IProxyRequest schedule message type handler methods
+
+func handleScheduleCreateRequest(requestCtx context.Context, request *messages.ScheduleCreateRequest) messages.IProxyReply {
+	scheduleID := *request.GetScheduleID()
+	clientID := request.GetClientID()
+	Logger.Debug("ScheduleCreateRequest Received",
+		zap.String("ScheduleId", scheduleID),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new ScheduleCreateReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	var opts client.ScheduleOptions
+	if v := request.GetOptions(); v != nil {
+		opts = *v
+	}
+
+	handle, err := clientHelper.ScheduleCreate(
+		ctx,
+		*request.GetNamespace(),
+		scheduleID,
+		opts)
+
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	reply.Build(nil, handle.GetID())
+
+	return reply
+}
+
+func handleScheduleDescribeRequest(requestCtx context.Context, request *messages.ScheduleDescribeRequest) messages.IProxyReply {
+	scheduleID := *request.GetScheduleID()
+	clientID := request.GetClientID()
+	Logger.Debug("ScheduleDescribeRequest Received",
+		zap.String("ScheduleId", scheduleID),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new ScheduleDescribeReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	description, err := clientHelper.ScheduleDescribe(ctx, *request.GetNamespace(), scheduleID)
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	reply.Build(nil, description)
+
+	return reply
+}
+
+func handleScheduleUpdateRequest(requestCtx context.Context, request *messages.ScheduleUpdateRequest) messages.IProxyReply {
+	scheduleID := *request.GetScheduleID()
+	clientID := request.GetClientID()
+	Logger.Debug("ScheduleUpdateRequest Received",
+		zap.String("ScheduleId", scheduleID),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new ScheduleUpdateReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	err := clientHelper.ScheduleUpdate(
+		ctx,
+		*request.GetNamespace(),
+		scheduleID,
+		request.GetSpec())
+
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	reply.Build(nil)
+
+	return reply
+}
+
+func handleScheduleDeleteRequest(requestCtx context.Context, request *messages.ScheduleDeleteRequest) messages.IProxyReply {
+	scheduleID := *request.GetScheduleID()
+	clientID := request.GetClientID()
+	Logger.Debug("ScheduleDeleteRequest Received",
+		zap.String("ScheduleId", scheduleID),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new ScheduleDeleteReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	err := clientHelper.ScheduleDelete(ctx, *request.GetNamespace(), scheduleID)
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	reply.Build(nil)
+
+	return reply
+}
+
+func handleScheduleListRequest(requestCtx context.Context, request *messages.ScheduleListRequest) messages.IProxyReply {
+	clientID := request.GetClientID()
+	Logger.Debug("ScheduleListRequest Received",
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new ScheduleListReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	schedules, err := clientHelper.ScheduleList(ctx, *request.GetNamespace(), request.GetPageSize())
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	reply.Build(nil, schedules)
+
+	return reply
+}
+
+func handleScheduleTriggerRequest(requestCtx context.Context, request *messages.ScheduleTriggerRequest) messages.IProxyReply {
+	scheduleID := *request.GetScheduleID()
+	clientID := request.GetClientID()
+	Logger.Debug("ScheduleTriggerRequest Received",
+		zap.String("ScheduleId", scheduleID),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new ScheduleTriggerReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	err := clientHelper.ScheduleTrigger(
+		ctx,
+		*request.GetNamespace(),
+		scheduleID,
+		request.GetOverlapPolicy())
+
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	reply.Build(nil)
+
+	return reply
+}
+
+func handleSchedulePauseRequest(requestCtx context.Context, request *messages.SchedulePauseRequest) messages.IProxyReply {
+	scheduleID := *request.GetScheduleID()
+	clientID := request.GetClientID()
+	Logger.Debug("SchedulePauseRequest Received",
+		zap.String("ScheduleId", scheduleID),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new SchedulePauseReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	note := ""
+	if v := request.GetNote(); v != nil {
+		note = *v
+	}
+
+	err := clientHelper.SchedulePause(ctx, *request.GetNamespace(), scheduleID, note)
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	reply.Build(nil)
+
+	return reply
+}
+
+func handleScheduleUnpauseRequest(requestCtx context.Context, request *messages.ScheduleUnpauseRequest) messages.IProxyReply {
+	scheduleID := *request.GetScheduleID()
+	clientID := request.GetClientID()
+	Logger.Debug("ScheduleUnpauseRequest Received",
+		zap.String("ScheduleId", scheduleID),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new ScheduleUnpauseReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	note := ""
+	if v := request.GetNote(); v != nil {
+		note = *v
+	}
+
+	err := clientHelper.ScheduleUnpause(ctx, *request.GetNamespace(), scheduleID, note)
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	reply.Build(nil)
+
+	return reply
+}
+
+func handleScheduleBackfillRequest(requestCtx context.Context, request *messages.ScheduleBackfillRequest) messages.IProxyReply {
+	scheduleID := *request.GetScheduleID()
+	clientID := request.GetClientID()
+	Logger.Debug("ScheduleBackfillRequest Received",
+		zap.String("ScheduleId", scheduleID),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new ScheduleBackfillReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	err := clientHelper.ScheduleBackfill(
+		ctx,
+		*request.GetNamespace(),
+		scheduleID,
+		request.GetStartTime(),
+		request.GetEndTime(),
+		request.GetOverlapPolicy())
+
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	reply.Build(nil)
+
+	return reply
+}
+
+func init() {
+	RegisterHandler(internal.ScheduleCreateRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleScheduleCreateRequest(requestCtx, request.(*messages.ScheduleCreateRequest))
+	})
+	RegisterHandler(internal.ScheduleDescribeRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleScheduleDescribeRequest(requestCtx, request.(*messages.ScheduleDescribeRequest))
+	})
+	RegisterHandler(internal.ScheduleUpdateRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleScheduleUpdateRequest(requestCtx, request.(*messages.ScheduleUpdateRequest))
+	})
+	RegisterHandler(internal.ScheduleDeleteRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleScheduleDeleteRequest(requestCtx, request.(*messages.ScheduleDeleteRequest))
+	})
+	RegisterHandler(internal.ScheduleListRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleScheduleListRequest(requestCtx, request.(*messages.ScheduleListRequest))
+	})
+	RegisterHandler(internal.ScheduleTriggerRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleScheduleTriggerRequest(requestCtx, request.(*messages.ScheduleTriggerRequest))
+	})
+	RegisterHandler(internal.SchedulePauseRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleSchedulePauseRequest(requestCtx, request.(*messages.SchedulePauseRequest))
+	})
+	RegisterHandler(internal.ScheduleUnpauseRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleScheduleUnpauseRequest(requestCtx, request.(*messages.ScheduleUnpauseRequest))
+	})
+	RegisterHandler(internal.ScheduleBackfillRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleScheduleBackfillRequest(requestCtx, request.(*messages.ScheduleBackfillRequest))
+	})
+}
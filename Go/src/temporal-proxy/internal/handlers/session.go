@@ -0,0 +1,264 @@
+// -----------------------------------------------------------------------------
+// FILE:		session.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"go.temporal.io/temporal/worker"
+
+	"temporal-proxy/internal"
+	"temporal-proxy/internal/messages"
+	proxyclient "temporal-proxy/internal/temporal/client"
+)
+
+type (
+
+	// Session holds everything specific to one connected Neon.Temporal
+	// client: its own ClientHelper, its own OperationRegistry and
+	// RequestID counter, a Context/CancelFunc pair that's cancelled when
+	// the Session is closed, the reply address it asked the proxy to
+	// PUT replies back to, the workers it has registered, and a Done
+	// channel other goroutines can select on to notice it was torn
+	// down. Keying these by ClientID in Clients, instead of reaching
+	// for one process-wide ClientHelper/OperationRegistry/RequestID
+	// counter, lets more than one Neon.Temporal client attach to the
+	// same proxy process without a second ConnectRequest clobbering the
+	// first, and lets Disconnect cancel one client's in-flight work
+	// without touching any other client's.
+	Session struct {
+		*proxyclient.ClientHelper
+
+		// Operations is this Session's own correlation/future registry
+		// for requests sent to its Neon.Temporal client, keyed by
+		// RequestID.
+		Operations *OperationRegistry
+
+		clientID int64
+
+		ctx    context.Context
+		cancel context.CancelFunc
+
+		nextRequestID int64
+
+		mu           sync.Mutex
+		replyAddress string
+		workers      []worker.Worker
+		done         chan struct{}
+		closed       bool
+	}
+
+	// ClientRegistry is a concurrent map of ClientID to Session,
+	// replacing the single global ClientHelper this proxy used to hold.
+	ClientRegistry struct {
+		mu       sync.RWMutex
+		sessions map[int64]*Session
+	}
+)
+
+// NewSession is the default constructor for a Session. It starts a
+// background reaper sweeping this Session's own OperationRegistry, which
+// stops once the Session is closed.
+//
+// params:
+//	- clientID int64 -> the ClientID of the Neon.Temporal client this
+//	Session belongs to.
+// 	- clientHelper *proxyclient.ClientHelper -> the ClientHelper this
+//	Session owns.
+//
+// returns *Session -> a pointer to a new Session in memory.
+func NewSession(clientID int64, clientHelper *proxyclient.ClientHelper) *Session {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	session := &Session{
+		ClientHelper: clientHelper,
+		Operations:   NewOperationRegistry(),
+		clientID:     clientID,
+		ctx:          ctx,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+	}
+
+	go session.Operations.StartReaper(_defaultReapInterval, _defaultReapAge, session.done)
+
+	return session
+}
+
+// GetClientID returns the ClientID this Session was registered under.
+func (session *Session) GetClientID() int64 {
+	return session.clientID
+}
+
+// Context returns the Context this Session's in-flight work should be
+// running under, cancelled once the Session is closed.
+func (session *Session) Context() context.Context {
+	return session.ctx
+}
+
+// NextRequestID returns the next monotonically increasing RequestID for
+// this Session, safe to call from multiple goroutines concurrently. Each
+// Session counts its own RequestIDs independently, so they're only
+// unique within that Session, not across every connected client.
+func (session *Session) NextRequestID() int64 {
+	return atomic.AddInt64(&session.nextRequestID, 1)
+}
+
+// GetReplyAddress returns the address this Session's replies should be
+// PUT back to.
+func (session *Session) GetReplyAddress() string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	return session.replyAddress
+}
+
+// SetReplyAddress sets the address this Session's replies should be PUT
+// back to.
+func (session *Session) SetReplyAddress(value string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	session.replyAddress = value
+}
+
+// AddWorker registers w as one of this Session's workers, so it gets
+// stopped when the Session is closed.
+func (session *Session) AddWorker(w worker.Worker) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	session.workers = append(session.workers, w)
+}
+
+// Done returns a channel that's closed once this Session has been
+// closed, for goroutines started on its behalf to select on.
+func (session *Session) Done() <-chan struct{} {
+	return session.done
+}
+
+// Close cancels this Session's Context, stops every worker it
+// registered, closes its ClientHelper's pooled clients, and signals Done,
+// without affecting any other Session's in-flight work, workers, or
+// connections.
+func (session *Session) Close() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if session.closed {
+		return
+	}
+	session.closed = true
+
+	session.cancel()
+	for _, w := range session.workers {
+		session.StopWorker(w)
+	}
+	if session.ClientHelper != nil {
+		session.CloseAll()
+	}
+	close(session.done)
+}
+
+// NewClientRegistry is the default constructor for a ClientRegistry.
+//
+// returns *ClientRegistry -> a pointer to a new ClientRegistry in memory.
+func NewClientRegistry() *ClientRegistry {
+	return &ClientRegistry{
+		sessions: make(map[int64]*Session),
+	}
+}
+
+// Add registers session under clientID, replacing any Session
+// previously registered under the same ClientID.
+func (registry *ClientRegistry) Add(clientID int64, session *Session) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.sessions[clientID] = session
+}
+
+// Get returns the Session registered under clientID, or nil if none is.
+func (registry *ClientRegistry) Get(clientID int64) *Session {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	return registry.sessions[clientID]
+}
+
+// Remove deregisters the Session at clientID, if any. It does not close
+// the Session; callers that want its workers stopped should call
+// Session.Close first.
+func (registry *ClientRegistry) Remove(clientID int64) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	delete(registry.sessions, clientID)
+}
+
+// Clients is the process-wide registry of connected Neon.Temporal
+// client Sessions, keyed by ClientID. It replaces the single global
+// ClientHelper a proxy process used to be limited to.
+var Clients = NewClientRegistry()
+
+// Disconnect tears down the Session registered under clientID, if any:
+// it cancels that Session's Context -- deterministically unblocking only
+// that client's in-flight work -- stops its workers, and deregisters it
+// from Clients, all without touching any other Session. It reports
+// whether a Session was found to tear down.
+func Disconnect(clientID int64) bool {
+	session := Clients.Get(clientID)
+	if session == nil {
+		return false
+	}
+
+	session.Close()
+	Clients.Remove(clientID)
+
+	return true
+}
+
+// handleTerminateRequest is the handler for a TerminateRequest. Unlike a
+// full process shutdown, it only tears down the Session belonging to
+// the requesting ClientID -- that Session's workers are stopped and its
+// ClientHelper's connections released, but every other connected
+// Neon.Temporal client's Session is left running.
+//
+// params:
+//	- requestCtx context.Context -> the context for the request.
+// 	- request *messages.TerminateRequest -> the request to handle.
+//
+// returns messages.IProxyReply -> the reply to the request.
+func handleTerminateRequest(requestCtx context.Context, request *messages.TerminateRequest) messages.IProxyReply {
+	reply := messages.CreateReplyMessage(request)
+
+	if !Disconnect(request.GetClientID()) {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	reply.Build(nil)
+	return reply
+}
+
+func init() {
+	RegisterHandler(internal.TerminateRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleTerminateRequest(requestCtx, request.(*messages.TerminateRequest))
+	})
+}
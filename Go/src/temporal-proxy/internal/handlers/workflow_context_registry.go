@@ -0,0 +1,97 @@
+// -----------------------------------------------------------------------------
+// FILE:		workflow_context_registry.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"sync"
+
+	proxyworkflow "temporal-proxy/internal/temporal/workflow"
+)
+
+// WorkflowContextRegistry is the concurrent map of ContextID to
+// *proxyworkflow.WorkflowContext that WorkflowContexts is.
+//
+// NOTE: this registry is still a single process-wide map, not one owned
+// per-Session like Session.Operations. Every handle*Request/handle*Reply
+// function that calls WorkflowContexts.Get/Add/Remove resolves a
+// ContextID without ever checking which ClientID registered it, so
+// nothing here stops one Neon.Temporal client from (accidentally or
+// otherwise) reaching a workflow.Context that belongs to another
+// client's workflow. Routing all of those call sites -- roughly two
+// dozen spread across workflow_request.go and workflow_reply.go -- by
+// ClientID is a larger follow-up than this registry's introduction: it
+// did not exist at all before this, so defining it here closes that gap
+// without yet making it safe to run mutually-distrusting clients against
+// the same proxy process.
+type WorkflowContextRegistry struct {
+	mu    sync.Mutex
+	items map[int64]*proxyworkflow.WorkflowContext
+}
+
+// NewWorkflowContextRegistry is the default constructor for a
+// WorkflowContextRegistry.
+//
+// returns *WorkflowContextRegistry -> a pointer to a new
+// WorkflowContextRegistry in memory.
+func NewWorkflowContextRegistry() *WorkflowContextRegistry {
+	return &WorkflowContextRegistry{
+		items: make(map[int64]*proxyworkflow.WorkflowContext),
+	}
+}
+
+// Add registers wectx under contextID, replacing any WorkflowContext
+// previously registered under the same ContextID, and returns contextID
+// back to the caller for convenient chaining (see
+// handleWorkflowRegisterRequest).
+func (registry *WorkflowContextRegistry) Add(contextID int64, wectx *proxyworkflow.WorkflowContext) int64 {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.items[contextID] = wectx
+
+	return contextID
+}
+
+// Get returns the WorkflowContext registered under contextID, or nil if
+// none is.
+func (registry *WorkflowContextRegistry) Get(contextID int64) *proxyworkflow.WorkflowContext {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	return registry.items[contextID]
+}
+
+// Remove deregisters the WorkflowContext at contextID, if any.
+func (registry *WorkflowContextRegistry) Remove(contextID int64) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	delete(registry.items, contextID)
+}
+
+// WorkflowContexts is the process-wide registry of open
+// workflow.Contexts this proxy is tracking on behalf of every connected
+// Neon.Temporal client, keyed by ContextID. See WorkflowContextRegistry's
+// NOTE for why this remains process-wide rather than per-Session.
+//
+// ActivityContexts, its counterpart for activities, is intentionally not
+// defined: unlike a workflow, an activity has no long-lived Context to
+// park anywhere (see handleActivityRegisterRequest's activityFunc) --
+// it runs once and returns, so there is nothing for an
+// ActivityContextRegistry to hold in this snapshot.
+var WorkflowContexts = NewWorkflowContextRegistry()
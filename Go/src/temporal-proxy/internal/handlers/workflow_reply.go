@@ -18,43 +18,77 @@
 package handlers
 
 import (
+	"context"
 	"errors"
 	"os"
+	"strconv"
 	"time"
 
 	"go.temporal.io/sdk/workflow"
 	"go.uber.org/zap"
 
 	"temporal-proxy/internal"
+	"temporal-proxy/internal/admin"
 	"temporal-proxy/internal/messages"
 )
 
 // -------------------------------------------------------------------------
 // Workflow message types
 
+// replayStatusSetter is implemented by any request or reply message that
+// carries a ReplayStatus property, letting setReplayStatus populate it
+// without caring whether v is outbound (an IProxyRequest headed to the
+// Neon.Temporal client) or inbound (an IProxyReply being settled).
+type replayStatusSetter interface {
+	SetReplayStatus(value internal.ReplayStatus)
+}
+
+// setReplayStatus sets v's ReplayStatus property by inspecting whether
+// ctx, the Context of the workflow execution v belongs to, is currently
+// replaying history versus executing for the first time.
+//
+// params:
+//	- ctx workflow.Context -> the context of the workflow v belongs to.
+// 	- v replayStatusSetter -> the request or reply to annotate.
+func setReplayStatus(ctx workflow.Context, v replayStatusSetter) {
+	if workflow.IsReplaying(ctx) {
+		v.SetReplayStatus(internal.ReplayStatusReplaying)
+	} else {
+		v.SetReplayStatus(internal.ReplayStatusNotReplaying)
+	}
+}
+
 func handleWorkflowInvokeReply(reply *messages.WorkflowInvokeReply, op *Operation) error {
 	defer WorkflowContexts.Remove(op.GetContextID())
+	defer func() { _ = Contexts.Delete(context.Background(), reply.GetClientID(), op.GetContextID()) }()
 
 	requestID := reply.GetRequestID()
 	contextID := op.GetContextID()
 	clientID := reply.GetClientID()
-	Logger.Debug("Settling Workflow",
-		zap.Int64("ClientId", clientID),
-		zap.Int64("ContextId", contextID),
-		zap.Int64("RequestId", requestID),
-		zap.Int("ProcessId", os.Getpid()))
+	if ce := Logger.Check(zap.DebugLevel, "Settling Workflow"); ce != nil {
+		ce.Write(
+			zap.Int64("ClientId", clientID),
+			zap.Int64("ContextId", contextID),
+			zap.Int64("RequestId", requestID),
+			zap.Int("ProcessId", os.Getpid()))
+	}
 
 	// WorkflowContext at the specified WorflowContextID
 	wectx := WorkflowContexts.Get(contextID)
 	if wectx == nil {
+		reportHandlerError(context.Background(), "WorkflowInvokeReply", requestID, internal.ErrEntityNotExist, map[string]string{
+			"context_id": strconv.FormatInt(contextID, 10),
+		})
 		return internal.ErrEntityNotExist
 	}
 
 	workflowName := *wectx.GetWorkflowName()
 	Logger.Debug("WorkflowInfo", zap.String("Workflow", workflowName))
 
-	// check for ForceReplay
-	if reply.GetForceReplay() {
+	// check for ForceReplay, either set on the reply itself or forced at
+	// runtime through the admin control endpoint (globally or for this
+	// workflow type specifically)
+	if reply.GetForceReplay() || admin.State.ShouldForceReplay(workflowName) {
 		return op.SendChannel(nil, internal.NewTemporalError(errors.New("force-replay")))
 	}
 
@@ -100,54 +134,139 @@ func handleWorkflowInvokeReply(reply *messages.WorkflowInvokeReply, op *Operatio
 		err = nil
 	}
 
-	// set the reply
-	return op.SendChannel(result, err)
+	// only pay for computing ReplayStatus when the invoke request asked for it
+	if invokeRequest, ok := op.GetRequest().(*messages.WorkflowInvokeRequest); ok && invokeRequest.GetReplayAware() {
+		setReplayStatus(wectx.GetContext(), reply)
+	}
+
+	// set the reply, retrying the round-trip with the Neon.Temporal
+	// client if err is a transient gRPC/transport failure rather than
+	// failing the workflow outright
+	return settleReply("WorkflowInvokeReply", op, result, err, _defaultReplyRetryPolicy)
 }
 
 func handleWorkflowSignalInvokeReply(reply *messages.WorkflowSignalInvokeReply, op *Operation) error {
 	requestID := reply.GetRequestID()
 	contextID := op.GetContextID()
-	Logger.Debug("Settling Signal",
-		zap.Int64("ClientId", reply.GetClientID()),
-		zap.Int64("ContextId", contextID),
-		zap.Int64("RequestId", requestID),
-		zap.Int("ProcessId", os.Getpid()))
+	if ce := Logger.Check(zap.DebugLevel, "Settling Signal"); ce != nil {
+		ce.Write(
+			zap.Int64("ClientId", reply.GetClientID()),
+			zap.Int64("ContextId", contextID),
+			zap.Int64("RequestId", requestID),
+			zap.Int("ProcessId", os.Getpid()))
+	}
 
 	// WorkflowContext at the specified WorflowContextID
-	if wectx := WorkflowContexts.Get(contextID); wectx == nil {
+	wectx := WorkflowContexts.Get(contextID)
+	if wectx == nil {
+		reportHandlerError(context.Background(), "WorkflowSignalInvokeReply", requestID, internal.ErrEntityNotExist, map[string]string{
+			"context_id": strconv.FormatInt(contextID, 10),
+		})
 		return internal.ErrEntityNotExist
 	}
 
-	// set the reply
-	return op.SendChannel(true, reply.GetError())
+	setReplayStatus(wectx.GetContext(), reply)
+
+	// set the reply, retrying the round-trip with the Neon.Temporal
+	// client if err is a transient gRPC/transport failure rather than
+	// failing the workflow outright
+	return settleReply("WorkflowSignalInvokeReply", op, true, reply.GetError(), _defaultReplyRetryPolicy)
 }
 
 func handleWorkflowQueryInvokeReply(reply *messages.WorkflowQueryInvokeReply, op *Operation) error {
 	requestID := reply.GetRequestID()
 	contextID := op.GetContextID()
-	Logger.Debug("Settling Query",
-		zap.Int64("ClientId", reply.GetClientID()),
-		zap.Int64("ContextId", contextID),
-		zap.Int64("RequestId", requestID),
-		zap.Int("ProcessId", os.Getpid()))
+	if ce := Logger.Check(zap.DebugLevel, "Settling Query"); ce != nil {
+		ce.Write(
+			zap.Int64("ClientId", reply.GetClientID()),
+			zap.Int64("ContextId", contextID),
+			zap.Int64("RequestId", requestID),
+			zap.Int("ProcessId", os.Getpid()))
+	}
 
 	// WorkflowContext at the specified WorflowContextID
-	if wectx := WorkflowContexts.Get(contextID); wectx == nil {
+	wectx := WorkflowContexts.Get(contextID)
+	if wectx == nil {
+		reportHandlerError(context.Background(), "WorkflowQueryInvokeReply", requestID, internal.ErrEntityNotExist, map[string]string{
+			"context_id": strconv.FormatInt(contextID, 10),
+		})
 		return internal.ErrEntityNotExist
 	}
 
-	// set the reply
-	return op.SendChannel(reply.GetResult(), reply.GetError())
+	setReplayStatus(wectx.GetContext(), reply)
+
+	// set the reply, retrying the round-trip with the Neon.Temporal
+	// client if err is a transient gRPC/transport failure rather than
+	// failing the workflow outright
+	return settleReply("WorkflowQueryInvokeReply", op, reply.GetResult(), reply.GetError(), _defaultReplyRetryPolicy)
+}
+
+func handleWorkflowUpdateInvokeReply(reply *messages.WorkflowUpdateInvokeReply, op *Operation) error {
+	requestID := reply.GetRequestID()
+	contextID := op.GetContextID()
+	if ce := Logger.Check(zap.DebugLevel, "Settling Update"); ce != nil {
+		ce.Write(
+			zap.Int64("ClientId", reply.GetClientID()),
+			zap.Int64("ContextId", contextID),
+			zap.Int64("RequestId", requestID),
+			zap.Int("ProcessId", os.Getpid()))
+	}
+
+	// WorkflowContext at the specified WorflowContextID
+	wectx := WorkflowContexts.Get(contextID)
+	if wectx == nil {
+		reportHandlerError(context.Background(), "WorkflowUpdateInvokeReply", requestID, internal.ErrEntityNotExist, map[string]string{
+			"context_id": strconv.FormatInt(contextID, 10),
+		})
+		return internal.ErrEntityNotExist
+	}
+
+	setReplayStatus(wectx.GetContext(), reply)
+
+	// set the reply, retrying the round-trip with the Neon.Temporal
+	// client if err is a transient gRPC/transport failure rather than
+	// failing the workflow outright
+	return settleReply("WorkflowUpdateInvokeReply", op, reply.GetResult(), reply.GetError(), _defaultReplyRetryPolicy)
+}
+
+func handleWorkflowUpdateValidateInvokeReply(reply *messages.WorkflowUpdateValidateInvokeReply, op *Operation) error {
+	requestID := reply.GetRequestID()
+	contextID := op.GetContextID()
+	if ce := Logger.Check(zap.DebugLevel, "Settling Update Validation"); ce != nil {
+		ce.Write(
+			zap.Int64("ClientId", reply.GetClientID()),
+			zap.Int64("ContextId", contextID),
+			zap.Int64("RequestId", requestID),
+			zap.Int("ProcessId", os.Getpid()))
+	}
+
+	// WorkflowContext at the specified WorflowContextID
+	wectx := WorkflowContexts.Get(contextID)
+	if wectx == nil {
+		reportHandlerError(context.Background(), "WorkflowUpdateValidateInvokeReply", requestID, internal.ErrEntityNotExist, map[string]string{
+			"context_id": strconv.FormatInt(contextID, 10),
+		})
+		return internal.ErrEntityNotExist
+	}
+
+	setReplayStatus(wectx.GetContext(), reply)
+
+	// set the reply, retrying the round-trip with the Neon.Temporal
+	// client if err is a transient gRPC/transport failure rather than
+	// failing the workflow outright
+	return settleReply("WorkflowUpdateValidateInvokeReply", op, nil, reply.GetError(), _defaultReplyRetryPolicy)
 }
 
 func handleWorkflowFutureReadyReply(reply *messages.WorkflowFutureReadyReply, op *Operation) error {
 	requestID := reply.GetRequestID()
 	contextID := op.GetContextID()
-	Logger.Debug("Settling Future ACK",
-		zap.Int64("ClientId", reply.GetClientID()),
-		zap.Int64("ContextId", contextID),
-		zap.Int64("RequestId", requestID),
-		zap.Int("ProcessId", os.Getpid()))
+	if ce := Logger.Check(zap.DebugLevel, "Settling Future ACK"); ce != nil {
+		ce.Write(
+			zap.Int64("ClientId", reply.GetClientID()),
+			zap.Int64("ContextId", contextID),
+			zap.Int64("RequestId", requestID),
+			zap.Int("ProcessId", os.Getpid()))
+	}
 
 	// set the reply
 	return op.SendChannel(true, nil)
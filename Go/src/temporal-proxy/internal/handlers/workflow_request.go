@@ -30,12 +30,69 @@ import (
 	"go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
 
 	"temporal-proxy/internal"
+	"temporal-proxy/internal/interceptors"
 	"temporal-proxy/internal/messages"
+	"temporal-proxy/internal/store"
+	proxyclient "temporal-proxy/internal/temporal/client"
 	proxyworkflow "temporal-proxy/internal/temporal/workflow"
 )
 
+// readIncomingHeaders extracts the raw payloads of the Temporal header
+// fields attached to ctx, keyed by field name. These are the headers set
+// by interceptors on the caller's side (auth tokens, tenant ids, tracing
+// baggage) that were propagated as part of the workflow's history.
+func readIncomingHeaders(ctx workflow.Context) map[string][]byte {
+	header := workflow.GetInfo(ctx).Header
+	if header == nil {
+		return nil
+	}
+
+	headers := make(map[string][]byte, len(header.GetFields()))
+	for name, payload := range header.GetFields() {
+		headers[name] = payload.GetData()
+	}
+
+	return headers
+}
+
+// withForwardedHeaders wraps ctx with the gRPC outgoing metadata carried by
+// headers, unless header forwarding has been globally disabled or there are
+// no headers to forward. This lets auth tokens, tenant ids, and tracing
+// baggage attached by the Neon.Temporal client flow through to interceptors
+// on both the client and worker sides.
+func withForwardedHeaders(ctx context.Context, headers map[string][]byte) context.Context {
+	if internal.DisableHeaderForwarding || len(headers) == 0 {
+		return ctx
+	}
+
+	md := make(metadata.MD, len(headers))
+	for key, value := range headers {
+		md.Append(key, string(value))
+	}
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// metadataToHeaders converts a captured gRPC response trailer into the
+// plain map[string][]string representation stored in a ProxyReply's
+// Headers property, or nil if header forwarding is disabled or the
+// trailer was empty.
+func metadataToHeaders(trailer metadata.MD) map[string][]string {
+	if internal.DisableHeaderForwarding || len(trailer) == 0 {
+		return nil
+	}
+
+	headers := make(map[string][]string, len(trailer))
+	for key, values := range trailer {
+		headers[key] = values
+	}
+
+	return headers
+}
+
 // ----------------------------------------------------------------------
 // IProxyRequest workflow message type handler methods
 
@@ -62,7 +119,7 @@ func handleWorkflowRegisterRequest(requestCtx context.Context, request *messages
 	// create workflow function
 	workflowFunc := func(ctx workflow.Context, input []byte) ([]byte, error) {
 		contextID := proxyworkflow.NextContextID()
-		requestID := NextRequestID()
+		requestID := clientHelper.NextRequestID()
 		Logger.Debug("Executing Workflow",
 			zap.String("Workflow", workflowName),
 			zap.Int64("ClientId", clientID),
@@ -76,6 +133,17 @@ func handleWorkflowRegisterRequest(requestCtx context.Context, request *messages
 		wectx.SetWorkflowName(&workflowName)
 		contextID = WorkflowContexts.Add(contextID, wectx)
 
+		// mirror this context into Contexts so a restarted proxy can
+		// recognize it as orphaned rather than silently dropping its reply
+		_ = Contexts.Put(context.Background(), store.ContextRecord{
+			ClientID:     clientID,
+			ContextID:    contextID,
+			RequestID:    requestID,
+			WorkflowName: workflowName,
+			Namespace:    workflow.GetInfo(ctx).Namespace,
+			CreatedAt:    time.Now(),
+		})
+
 		// Send a WorkflowInvokeRequest to the Neon.Temporal Lib
 		// temporal-client
 		invokeRequest := messages.NewWorkflowInvokeRequest()
@@ -96,6 +164,12 @@ func handleWorkflowRegisterRequest(requestCtx context.Context, request *messages
 		invokeRequest.SetTaskQueue(&workflowInfo.TaskQueueName)
 		invokeRequest.SetExecutionStartToCloseTimeout(time.Duration(int64(workflowInfo.WorkflowExecutionTimeout) * int64(time.Second)))
 
+		// forward the inbound gRPC headers so the Neon.Temporal client
+		// sees them in its own interceptors
+		if !internal.DisableHeaderForwarding {
+			invokeRequest.SetHeaders(readIncomingHeaders(ctx))
+		}
+
 		// set ReplayStatus
 		setReplayStatus(ctx, invokeRequest)
 
@@ -103,7 +177,9 @@ func handleWorkflowRegisterRequest(requestCtx context.Context, request *messages
 		op := NewOperation(requestID, invokeRequest)
 		op.SetChannel(make(chan interface{}))
 		op.SetContextID(contextID)
-		Operations.Add(requestID, op)
+		op.SetClientID(clientID)
+		clientHelper.Operations.Add(requestID, op)
+		putOperationRecord(clientID, op)
 
 		// send invokeRequest
 		go sendMessage(invokeRequest)
@@ -191,11 +267,26 @@ func handleWorkflowExecuteRequest(requestCtx context.Context, request *messages.
 	// create the context
 	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
 	defer cancel()
+	ctx = withForwardedHeaders(ctx, request.GetHeaders())
 
 	// check for options
 	var opts client.StartWorkflowOptions
 	if v := request.GetOptions(); v != nil {
 		opts = *v
+	} else {
+		opts.EnableEagerStart = internal.DefaultEnableEagerWorkflowStart
+	}
+
+	// EagerStart lets this specific request ask for eager dispatch even
+	// when its Options didn't request it (or had none set at all),
+	// asking the Temporal server to return the workflow's first task
+	// inline in the StartWorkflowExecution response rather than leaving
+	// it for a worker to pick up with a task-queue poll. The SDK client
+	// and worker handle the inline task transparently -- including
+	// falling back to a normal poll if the server declines -- so there's
+	// no separate code path here for the eager-vs-polled cases.
+	if request.GetEagerStart() {
+		opts.EnableEagerStart = true
 	}
 
 	// signalwithstart the specified workflow
@@ -244,6 +335,7 @@ func handleWorkflowCancelRequest(requestCtx context.Context, request *messages.W
 	// create the context to cancel the workflow
 	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
 	defer cancel()
+	ctx = withForwardedHeaders(ctx, request.GetHeaders())
 
 	// cancel the specified workflow
 	err := clientHelper.CancelWorkflow(
@@ -285,6 +377,7 @@ func handleWorkflowTerminateRequest(requestCtx context.Context, request *message
 	// create the context to terminate the workflow
 	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
 	defer cancel()
+	ctx = withForwardedHeaders(ctx, request.GetHeaders())
 
 	// terminate the specified workflow
 	err := clientHelper.TerminateWorkflow(
@@ -328,6 +421,7 @@ func handleWorkflowSignalWithStartRequest(requestCtx context.Context, request *m
 	// create the context
 	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
 	defer cancel()
+	ctx = withForwardedHeaders(ctx, request.GetHeaders())
 
 	// signalwithstart the specified workflow
 	execution, err := clientHelper.SignalWithStartWorkflow(
@@ -350,6 +444,143 @@ func handleWorkflowSignalWithStartRequest(requestCtx context.Context, request *m
 	return reply
 }
 
+func handleWorkflowUpdateRequest(requestCtx context.Context, request *messages.WorkflowUpdateRequest) messages.IProxyReply {
+	workflowID := *request.GetWorkflowID()
+	runID := *request.GetRunID()
+	updateName := *request.GetUpdateName()
+	clientID := request.GetClientID()
+	Logger.Debug("WorkflowUpdateRequest Received",
+		zap.String("UpdateName", updateName),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.String("WorkflowId", workflowID),
+		zap.String("RunId", runID),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new WorkflowUpdateReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+	ctx = withForwardedHeaders(ctx, request.GetHeaders())
+
+	updateID := ""
+	if v := request.GetUpdateID(); v != nil {
+		updateID = *v
+	}
+
+	waitForStage := request.GetWaitForStage()
+	handle, err := clientHelper.UpdateWorkflow(ctx, *request.GetNamespace(), client.UpdateWorkflowOptions{
+		UpdateID:     updateID,
+		WorkflowID:   workflowID,
+		RunID:        runID,
+		UpdateName:   updateName,
+		Args:         []interface{}{request.GetArgs()},
+		WaitForStage: waitForStage,
+	})
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	var result []byte
+	if waitForStage == client.WorkflowUpdateStageCompleted {
+		if err := handle.Get(ctx, &result); err != nil {
+			reply.Build(err)
+			return reply
+		}
+	}
+
+	reply.Build(nil, append(make([]interface{}, 0), handle.UpdateID(), result))
+
+	return reply
+}
+
+func handleWorkflowUpdateWithStartRequest(requestCtx context.Context, request *messages.WorkflowUpdateWithStartRequest) messages.IProxyReply {
+	workflowName := *request.GetWorkflow()
+	workflowID := *request.GetWorkflowID()
+	updateName := *request.GetUpdateName()
+	clientID := request.GetClientID()
+	Logger.Debug("WorkflowUpdateWithStartRequest Received",
+		zap.String("Workflow", workflowName),
+		zap.String("UpdateName", updateName),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.String("WorkflowId", workflowID),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new WorkflowUpdateWithStartReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+	ctx = withForwardedHeaders(ctx, request.GetHeaders())
+
+	var opts client.StartWorkflowOptions
+	if v := request.GetOptions(); v != nil {
+		opts = *v
+	}
+	opts.ID = workflowID
+
+	updateID := ""
+	if v := request.GetUpdateID(); v != nil {
+		updateID = *v
+	}
+
+	// start the workflow (an already-started error is ignored), then send
+	// it the update in a separate UpdateWorkflow call
+	_, err := clientHelper.ExecuteWorkflow(
+		ctx,
+		*request.GetNamespace(),
+		opts,
+		workflowName,
+		request.GetWorkflowArgs())
+
+	if err != nil && !internal.IsWorkflowExecutionAlreadyStartedError(err) {
+		reply.Build(err)
+		return reply
+	}
+
+	waitForStage := request.GetWaitForStage()
+	handle, err := clientHelper.UpdateWorkflow(ctx, *request.GetNamespace(), client.UpdateWorkflowOptions{
+		UpdateID:     updateID,
+		WorkflowID:   workflowID,
+		UpdateName:   updateName,
+		Args:         []interface{}{request.GetUpdateArgs()},
+		WaitForStage: waitForStage,
+	})
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	var result []byte
+	if waitForStage == client.WorkflowUpdateStageCompleted {
+		if err := handle.Get(ctx, &result); err != nil {
+			reply.Build(err)
+			return reply
+		}
+	}
+
+	reply.Build(nil, append(make([]interface{}, 0), handle.UpdateID(), result))
+
+	return reply
+}
+
 func handleWorkflowSetCacheSizeRequest(requestCtx context.Context, request *messages.WorkflowSetCacheSizeRequest) messages.IProxyReply {
 	Logger.Debug("WorkflowSetCacheSizeRequest Received",
 		zap.Int64("ClientId", request.GetClientID()),
@@ -462,6 +693,7 @@ func handleWorkflowDescribeExecutionRequest(requestCtx context.Context, request
 	// create the context
 	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
 	defer cancel()
+	ctx = withForwardedHeaders(ctx, request.GetHeaders())
 
 	// DescribeWorkflow call to temporal client
 	dwer, err := clientHelper.DescribeWorkflowExecution(
@@ -499,6 +731,7 @@ func handleWorkflowGetResultRequest(requestCtx context.Context, request *message
 	// create the context
 	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
 	defer cancel()
+	ctx = withForwardedHeaders(ctx, request.GetHeaders())
 
 	// call GetWorkflow
 	workflowRun, err := clientHelper.GetWorkflow(
@@ -541,6 +774,12 @@ func handleWorkflowSignalSubscribeRequest(requestCtx context.Context, request *m
 	// new WorkflowSignalSubscribeReply
 	reply := messages.CreateReplyMessage(request)
 
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
 	// get the contextID and the corresponding context
 	wectx := WorkflowContexts.Get(contextID)
 	if wectx == nil {
@@ -561,7 +800,7 @@ func handleWorkflowSignalSubscribeRequest(requestCtx context.Context, request *m
 			zap.ByteString("args", signalArgs))
 
 		// create the WorkflowSignalInvokeRequest
-		requestID := NextRequestID()
+		requestID := clientHelper.NextRequestID()
 		invokeRequest := messages.NewWorkflowSignalInvokeRequest()
 		invokeRequest.SetRequestID(requestID)
 		invokeRequest.SetContextID(contextID)
@@ -570,6 +809,11 @@ func handleWorkflowSignalSubscribeRequest(requestCtx context.Context, request *m
 		invokeRequest.SetClientID(clientID)
 		invokeRequest.SetWorkerID(workerID)
 
+		// forward the inbound gRPC headers
+		if !internal.DisableHeaderForwarding {
+			invokeRequest.SetHeaders(readIncomingHeaders(ctx))
+		}
+
 		// set ReplayStatus
 		setReplayStatus(ctx, invokeRequest)
 
@@ -577,7 +821,9 @@ func handleWorkflowSignalSubscribeRequest(requestCtx context.Context, request *m
 		op := NewOperation(requestID, invokeRequest)
 		op.SetChannel(make(chan interface{}))
 		op.SetContextID(contextID)
-		Operations.Add(requestID, op)
+		op.SetClientID(clientID)
+		clientHelper.Operations.Add(requestID, op)
+		putOperationRecord(clientID, op)
 
 		// send the request
 		go sendMessage(invokeRequest)
@@ -664,6 +910,7 @@ func handleWorkflowSignalRequest(requestCtx context.Context, request *messages.W
 	// create the context to signal the workflow
 	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
 	defer cancel()
+	ctx = withForwardedHeaders(ctx, request.GetHeaders())
 
 	// signal the specified workflow
 	err := clientHelper.SignalWorkflow(
@@ -871,6 +1118,17 @@ func handleWorkflowExecuteChildRequest(requestCtx context.Context, request *mess
 	var opts workflow.ChildWorkflowOptions
 	if v := request.GetOptions(); v != nil {
 		opts = *v
+	} else {
+		opts.EnableEagerStart = internal.DefaultEnableEagerWorkflowStart
+	}
+
+	// run the outbound interceptor chain, giving registered
+	// interceptors (tracing, payload codecs, authorization) a chance to
+	// observe or rewrite the call before it's issued
+	call := &interceptors.Call{ClientID: clientID, ContextID: contextID, Name: workflowName, Args: request.GetArgs()}
+	if err := interceptors.InterceptWorkflowOutbound(ctx, call); err != nil {
+		reply.Build(err)
+		return reply
 	}
 
 	// set cancellation on the context
@@ -878,7 +1136,7 @@ func handleWorkflowExecuteChildRequest(requestCtx context.Context, request *mess
 	ctx = workflow.WithChildOptions(ctx, opts)
 	ctx = workflow.WithScheduleToStartTimeout(ctx, request.GetScheduleToStartTimeout())
 	ctx, cancel := workflow.WithCancel(ctx)
-	childFuture := workflow.ExecuteChildWorkflow(ctx, workflowName, request.GetArgs())
+	childFuture := workflow.ExecuteChildWorkflow(ctx, workflowName, call.Args)
 
 	// create the new ChildContext
 	// add the ChildWorkflowFuture and the cancel func to the
@@ -987,11 +1245,20 @@ func handleWorkflowSignalChildRequest(requestCtx context.Context, request *messa
 	ctx := wectx.GetContext()
 	setReplayStatus(ctx, reply)
 
+	// run the outbound interceptor chain, giving registered
+	// interceptors (tracing, payload codecs, authorization) a chance to
+	// observe or rewrite the call before it's issued
+	call := &interceptors.Call{ClientID: clientID, ContextID: contextID, Name: signalName, Args: request.GetSignalArgs()}
+	if err := interceptors.InterceptWorkflowOutbound(ctx, call); err != nil {
+		reply.Build(err)
+		return reply
+	}
+
 	// signal the child workflow
 	future := cctx.GetFuture().SignalChildWorkflow(
 		ctx,
 		signalName,
-		request.GetSignalArgs())
+		call.Args)
 
 	// wait on the future
 	var result []byte
@@ -1031,13 +1298,69 @@ func handleWorkflowCancelChildRequest(requestCtx context.Context, request *messa
 		return reply
 	}
 
+	ctx := wectx.GetContext()
+
+	// set replaying
+	setReplayStatus(ctx, reply)
+
+	cancellationType := request.GetCancellationType()
+
+	// ABANDON never requests cancellation at all; the child keeps running
+	// and the proxy stops waiting on it immediately.
+	if cancellationType != workflow.ABANDON {
+		cancel := cctx.GetCancelFunction()
+		cancel()
+	}
+
+	// TRY_CANCEL replies as soon as cancellation has been requested; the
+	// WAIT_* variants block until the child's future settles before
+	// replying.
+	if cancellationType == workflow.WAIT_CANCELLATION_REQUESTED || cancellationType == workflow.WAIT_CANCELLATION_COMPLETED {
+		var temporalError *internal.TemporalError
+		s := workflow.NewSelector(ctx)
+		s.AddFuture(cctx.GetFuture(), func(f workflow.Future) {
+			var result []byte
+			if err := f.Get(ctx, &result); err != nil {
+				temporalError = internal.NewTemporalError(err, internal.CanceledError)
+			}
+		})
+		s.Select(ctx)
+
+		reply.Build(temporalError)
+		return reply
+	}
+
+	reply.Build(nil)
+
+	return reply
+}
+
+func handleWorkflowDetachChildRequest(requestCtx context.Context, request *messages.WorkflowDetachChildRequest) messages.IProxyReply {
+	contextID := request.GetContextID()
+	childID := request.GetChildID()
+	Logger.Debug("WorkflowDetachChildRequest Received",
+		zap.Int64("ChildId", childID),
+		zap.Int64("ClientId", request.GetClientID()),
+		zap.Int64("ContextId", contextID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new WorkflowDetachChildReply
+	reply := messages.CreateReplyMessage(request)
+
+	// get the child context from the parent workflow context
+	wectx := WorkflowContexts.Get(contextID)
+	if wectx == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
 	// set replaying
 	setReplayStatus(wectx.GetContext(), reply)
 
-	// get cancel function
-	// call the cancel function
-	cancel := cctx.GetCancelFunction()
-	cancel()
+	// forget the child without cancelling it; it continues running
+	// independently of its parent
+	wectx.RemoveChild(childID)
 
 	reply.Build(nil)
 
@@ -1060,6 +1383,12 @@ func handleWorkflowSetQueryHandlerRequest(requestCtx context.Context, request *m
 	// new WorkflowSetQueryHandlerReply
 	reply := messages.CreateReplyMessage(request)
 
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
 	// get the workflow context
 	wectx := WorkflowContexts.Get(contextID)
 	if wectx == nil {
@@ -1070,7 +1399,7 @@ func handleWorkflowSetQueryHandlerRequest(requestCtx context.Context, request *m
 	// define the handler function
 	ctx := wectx.GetContext()
 	queryHandler := func(queryArgs []byte) ([]byte, error) {
-		requestID := NextRequestID()
+		requestID := clientHelper.NextRequestID()
 		Logger.Debug("Workflow Queried",
 			zap.String("Query", queryName),
 			zap.Int64("ClientId", clientID),
@@ -1079,6 +1408,16 @@ func handleWorkflowSetQueryHandlerRequest(requestCtx context.Context, request *m
 			zap.Int64("RequestId", requestID),
 			zap.Int("ProcessId", os.Getpid()))
 
+		// run the inbound interceptor chain, giving registered
+		// interceptors (tracing, authorization, payload codecs) a
+		// chance to observe, reject, or rewrite the query before it's
+		// forwarded to the client's registered handler
+		call := &interceptors.Call{ClientID: clientID, ContextID: contextID, Name: queryName, Args: queryArgs}
+		if err := interceptors.InterceptWorkflowInbound(ctx, call); err != nil {
+			return nil, err
+		}
+		queryArgs = call.Args
+
 		invokeRequest := messages.NewWorkflowQueryInvokeRequest()
 		invokeRequest.SetRequestID(requestID)
 		invokeRequest.SetContextID(contextID)
@@ -1094,7 +1433,9 @@ func handleWorkflowSetQueryHandlerRequest(requestCtx context.Context, request *m
 		op := NewOperation(requestID, invokeRequest)
 		op.SetContextID(contextID)
 		op.SetChannel(make(chan interface{}))
-		Operations.Add(requestID, op)
+		op.SetClientID(clientID)
+		clientHelper.Operations.Add(requestID, op)
+		putOperationRecord(clientID, op)
 
 		// send the request
 		go sendMessage(invokeRequest)
@@ -1161,20 +1502,20 @@ func handleWorkflowSetQueryHandlerRequest(requestCtx context.Context, request *m
 	return reply
 }
 
-func handleWorkflowQueryRequest(requestCtx context.Context, request *messages.WorkflowQueryRequest) messages.IProxyReply {
-	workflowID := *request.GetWorkflowID()
-	runID := *request.GetRunID()
+func handleWorkflowSetUpdateHandlerRequest(requestCtx context.Context, request *messages.WorkflowSetUpdateHandlerRequest) messages.IProxyReply {
+	contextID := request.GetContextID()
 	clientID := request.GetClientID()
-	queryName := *request.GetQueryName()
-	Logger.Debug("WorkflowQueryRequest Received",
-		zap.String("QueryName", queryName),
+	workerID := request.GetWorkerID()
+	updateName := *request.GetUpdateName()
+	Logger.Debug("WorkflowSetUpdateHandlerRequest Received",
+		zap.String("UpdateName", updateName),
 		zap.Int64("ClientId", clientID),
+		zap.Int64("ContextId", contextID),
+		zap.Int64("WorkerId", workerID),
 		zap.Int64("RequestId", request.GetRequestID()),
-		zap.String("WorkflowId", workflowID),
-		zap.String("RunId", runID),
 		zap.Int("ProcessId", os.Getpid()))
 
-	// new WorkflowQueryReply
+	// new WorkflowSetUpdateHandlerReply
 	reply := messages.CreateReplyMessage(request)
 
 	clientHelper := Clients.Get(clientID)
@@ -1183,64 +1524,267 @@ func handleWorkflowQueryRequest(requestCtx context.Context, request *messages.Wo
 		return reply
 	}
 
-	// create the context
-	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
-	defer cancel()
-
-	// query the workflow via the temporal client
-	value, err := clientHelper.QueryWorkflow(
-		ctx,
-		workflowID,
-		runID,
-		*request.GetNamespace(),
-		queryName,
-		request.GetQueryArgs())
-
-	if err != nil {
-		reply.Build(err)
-		return reply
-	}
-
-	// extract the result
-	var result []byte
-	if value.HasValue() {
-		err = value.Get(&result)
-		if err != nil {
-			reply.Build(err)
-			return reply
-		}
-	}
-
-	reply.Build(nil, result)
-
-	return reply
-}
-
-func handleWorkflowGetVersionRequest(requestCtx context.Context, request *messages.WorkflowGetVersionRequest) messages.IProxyReply {
-	contextID := request.GetContextID()
-	Logger.Debug("WorkflowGetVersionRequest Received",
-		zap.Int64("ClientId", request.GetClientID()),
-		zap.Int64("ContextId", contextID),
-		zap.Int64("RequestId", request.GetRequestID()),
-		zap.Int("ProcessId", os.Getpid()))
-
-	// new WorkflowGetVersionReply
-	reply := messages.CreateReplyMessage(request)
-
-	// get the child context from the parent workflow context
+	// get the workflow context
 	wectx := WorkflowContexts.Get(contextID)
 	if wectx == nil {
 		reply.Build(internal.ErrEntityNotExist)
 		return reply
 	}
 
+	// define the validator and handler functions
 	ctx := wectx.GetContext()
+	updateValidator := func(ctx workflow.Context, updateArgs []byte) error {
+		requestID := clientHelper.NextRequestID()
+		updateID := workflow.GetCurrentUpdateInfo(ctx).ID
+		Logger.Debug("Workflow Update Validating",
+			zap.String("Update", updateName),
+			zap.Int64("ClientId", clientID),
+			zap.Int64("ContextId", contextID),
+			zap.Int64("WorkerId", workerID),
+			zap.Int64("RequestId", requestID),
+			zap.Int("ProcessId", os.Getpid()))
 
-	// set ReplayStatus
-	setReplayStatus(ctx, reply)
+		invokeRequest := messages.NewWorkflowUpdateValidateInvokeRequest()
+		invokeRequest.SetRequestID(requestID)
+		invokeRequest.SetContextID(contextID)
+		invokeRequest.SetUpdateName(&updateName)
+		invokeRequest.SetUpdateID(&updateID)
+		invokeRequest.SetArgs(updateArgs)
+		invokeRequest.SetClientID(clientID)
+		invokeRequest.SetWorkerID(workerID)
 
-	// get the workflow version
-	version := workflow.GetVersion(
+		// set ReplayStatus
+		setReplayStatus(ctx, invokeRequest)
+
+		// create the Operation for this request and add it to the operations map
+		op := NewOperation(requestID, invokeRequest)
+		op.SetContextID(contextID)
+		op.SetChannel(make(chan interface{}))
+		op.SetClientID(clientID)
+		clientHelper.Operations.Add(requestID, op)
+		putOperationRecord(clientID, op)
+
+		// send the request
+		go sendMessage(invokeRequest)
+
+		// wait for InvokeReply
+		result := <-op.GetChannel()
+		if err, ok := result.(error); ok {
+			Logger.Error("Update Rejected By Validator",
+				zap.String("Update", updateName),
+				zap.Int64("ClientId", clientID),
+				zap.Int64("ContextId", contextID),
+				zap.Int64("WorkerId", workerID),
+				zap.Int64("RequestId", requestID),
+				zap.Error(err),
+				zap.Int("ProcessId", os.Getpid()))
+
+			return err
+		}
+
+		return nil
+	}
+
+	updateHandler := func(ctx workflow.Context, updateArgs []byte) ([]byte, error) {
+		requestID := clientHelper.NextRequestID()
+		updateID := workflow.GetCurrentUpdateInfo(ctx).ID
+		Logger.Debug("Workflow Updated",
+			zap.String("Update", updateName),
+			zap.Int64("ClientId", clientID),
+			zap.Int64("ContextId", contextID),
+			zap.Int64("WorkerId", workerID),
+			zap.Int64("RequestId", requestID),
+			zap.Int("ProcessId", os.Getpid()))
+
+		invokeRequest := messages.NewWorkflowUpdateInvokeRequest()
+		invokeRequest.SetRequestID(requestID)
+		invokeRequest.SetContextID(contextID)
+		invokeRequest.SetUpdateName(&updateName)
+		invokeRequest.SetUpdateID(&updateID)
+		invokeRequest.SetArgs(updateArgs)
+		invokeRequest.SetClientID(clientID)
+		invokeRequest.SetWorkerID(workerID)
+
+		// forward the inbound gRPC headers
+		if !internal.DisableHeaderForwarding {
+			invokeRequest.SetHeaders(readIncomingHeaders(ctx))
+		}
+
+		// set ReplayStatus
+		setReplayStatus(ctx, invokeRequest)
+
+		// create the Operation for this request and add it to the operations map
+		op := NewOperation(requestID, invokeRequest)
+		op.SetContextID(contextID)
+		op.SetChannel(make(chan interface{}))
+		op.SetClientID(clientID)
+		clientHelper.Operations.Add(requestID, op)
+		putOperationRecord(clientID, op)
+
+		// send the request
+		go sendMessage(invokeRequest)
+
+		// wait for InvokeReply
+		result := <-op.GetChannel()
+		switch s := result.(type) {
+		case error:
+			Logger.Error("Update Failed With Error",
+				zap.String("Update", updateName),
+				zap.Int64("ClientId", clientID),
+				zap.Int64("ContextId", contextID),
+				zap.Int64("WorkerId", workerID),
+				zap.Int64("RequestId", requestID),
+				zap.Error(s),
+				zap.Int("ProcessId", os.Getpid()))
+
+			return nil, s
+
+		case []byte:
+			Logger.Info("Update Completed Successfully",
+				zap.String("Update", updateName),
+				zap.Int64("ClientId", clientID),
+				zap.Int64("ContextId", contextID),
+				zap.Int64("WorkerId", workerID),
+				zap.Int64("RequestId", requestID),
+				zap.Int("ProcessId", os.Getpid()))
+
+			return s, nil
+
+		default:
+			Logger.Error("Update result unexpected",
+				zap.String("Update", updateName),
+				zap.Int64("ClientId", clientID),
+				zap.Int64("ContextId", contextID),
+				zap.Int64("WorkerId", workerID),
+				zap.Int64("RequestId", requestID),
+				zap.Any("Result", s),
+				zap.Int("ProcessId", os.Getpid()))
+
+			return nil, fmt.Errorf("unexpected result type %v.  result must be an error or []byte", reflect.TypeOf(s))
+		}
+	}
+
+	// register the update handler and validator with the temporal server
+	err := workflow.SetUpdateHandlerWithOptions(ctx, updateName, updateHandler, workflow.UpdateHandlerOptions{
+		Validator: updateValidator,
+	})
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	reply.Build(nil)
+
+	return reply
+}
+
+func handleWorkflowQueryRequest(requestCtx context.Context, request *messages.WorkflowQueryRequest) messages.IProxyReply {
+	workflowID := *request.GetWorkflowID()
+	runID := *request.GetRunID()
+	clientID := request.GetClientID()
+	queryName := *request.GetQueryName()
+	Logger.Debug("WorkflowQueryRequest Received",
+		zap.String("QueryName", queryName),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.String("WorkflowId", workflowID),
+		zap.String("RunId", runID),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new WorkflowQueryReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	// run the client interceptor chain, giving registered interceptors
+	// (tracing, authorization, payload codecs) a chance to observe,
+	// reject, or rewrite the query before it's issued to the server
+	call := &interceptors.Call{ClientID: clientID, Namespace: *request.GetNamespace(), Name: queryName, Args: request.GetQueryArgs()}
+	if err := interceptors.InterceptClientCall(ctx, call); err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	// capture the gRPC response trailer the server returns while handling
+	// the query, so it can be surfaced back to the caller via reply.Headers
+	ctx, trailer := proxyclient.WithTrailerCapture(ctx)
+	defer func() {
+		if headers := metadataToHeaders(*trailer); headers != nil {
+			reply.SetHeaders(headers)
+		}
+	}()
+
+	// query the workflow via the temporal client
+	value, err := clientHelper.QueryWorkflow(
+		ctx,
+		workflowID,
+		runID,
+		*request.GetNamespace(),
+		queryName,
+		call.Args)
+
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	// extract the result
+	var result []byte
+	if value.HasValue() {
+		err = value.Get(&result)
+		if err != nil {
+			reply.Build(err)
+			return reply
+		}
+	}
+
+	reply.Build(nil, result)
+
+	return reply
+}
+
+func handleWorkflowGetVersionRequest(requestCtx context.Context, request *messages.WorkflowGetVersionRequest) messages.IProxyReply {
+	contextID := request.GetContextID()
+	Logger.Debug("WorkflowGetVersionRequest Received",
+		zap.Int64("ClientId", request.GetClientID()),
+		zap.Int64("ContextId", contextID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new WorkflowGetVersionReply
+	reply := messages.CreateReplyMessage(request)
+
+	// get the child context from the parent workflow context
+	wectx := WorkflowContexts.Get(contextID)
+	if wectx == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	ctx := wectx.GetContext()
+
+	// set ReplayStatus
+	setReplayStatus(ctx, reply)
+
+	// run the inbound interceptor chain, giving registered interceptors
+	// (tracing, authorization) a chance to observe or reject the
+	// version check before it's recorded in the workflow's history
+	call := &interceptors.Call{ClientID: request.GetClientID(), ContextID: contextID, Name: *request.GetChangeID()}
+	if err := interceptors.InterceptWorkflowInbound(ctx, call); err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	// get the workflow version
+	version := workflow.GetVersion(
 		ctx,
 		*request.GetChangeID(),
 		workflow.Version(request.GetMinSupported()),
@@ -1315,7 +1859,16 @@ func handleWorkflowQueueWriteRequest(requestCtx context.Context, request *messag
 	// set ReplayStatus
 	setReplayStatus(ctx, reply)
 
-	data := request.GetData()
+	// run the outbound interceptor chain, giving registered
+	// interceptors (payload codecs, rate limiting) a chance to observe
+	// or rewrite the value before it's enqueued
+	call := &interceptors.Call{ClientID: request.GetClientID(), ContextID: contextID, Args: request.GetData()}
+	if err := interceptors.InterceptWorkflowOutbound(ctx, call); err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	data := call.Args
 	queue := wectx.GetQueue(queueID)
 	if queue == nil {
 		reply.Build(internal.ErrEntityNotExist)
@@ -1414,11 +1967,335 @@ func handleWorkflowQueueReadRequest(requestCtx context.Context, request *message
 	})
 	s.Select(ctx)
 
+	// run the inbound interceptor chain, giving registered interceptors
+	// (payload codecs) a chance to observe or rewrite the value before
+	// it's returned to the caller
+	if temporalError == nil && data != nil {
+		call := &interceptors.Call{ClientID: request.GetClientID(), ContextID: contextID, Args: data}
+		if err := interceptors.InterceptWorkflowInbound(ctx, call); err != nil {
+			temporalError = internal.NewTemporalError(err)
+		} else {
+			data = call.Args
+		}
+	}
+
 	reply.Build(temporalError, append(make([]interface{}, 0), data, isClosed))
 
 	return reply
 }
 
+func handleWorkflowBatchTerminateRequest(requestCtx context.Context, request *messages.WorkflowBatchTerminateRequest) messages.IProxyReply {
+	clientID := request.GetClientID()
+	query := *request.GetQuery()
+	Logger.Debug("WorkflowBatchTerminateRequest Received",
+		zap.String("Query", query),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new WorkflowBatchTerminateReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	count, err := clientHelper.CountWorkflow(ctx, *request.GetNamespace(), query)
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	jobID, err := clientHelper.BatchTerminate(ctx, *request.GetNamespace(), query, *request.GetReason(), request.GetDetails())
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	Logger.Info("Batch Terminate Started",
+		zap.String("JobId", jobID),
+		zap.Int64("MatchedWorkflows", count))
+
+	reply.Build(nil, jobID)
+
+	return reply
+}
+
+func handleWorkflowBatchCancelRequest(requestCtx context.Context, request *messages.WorkflowBatchCancelRequest) messages.IProxyReply {
+	clientID := request.GetClientID()
+	query := *request.GetQuery()
+	Logger.Debug("WorkflowBatchCancelRequest Received",
+		zap.String("Query", query),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new WorkflowBatchCancelReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	count, err := clientHelper.CountWorkflow(ctx, *request.GetNamespace(), query)
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	jobID, err := clientHelper.BatchCancel(ctx, *request.GetNamespace(), query, *request.GetReason())
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	Logger.Info("Batch Cancel Started",
+		zap.String("JobId", jobID),
+		zap.Int64("MatchedWorkflows", count))
+
+	reply.Build(nil, jobID)
+
+	return reply
+}
+
+func handleWorkflowBatchSignalRequest(requestCtx context.Context, request *messages.WorkflowBatchSignalRequest) messages.IProxyReply {
+	clientID := request.GetClientID()
+	query := *request.GetQuery()
+	signalName := *request.GetSignalName()
+	Logger.Debug("WorkflowBatchSignalRequest Received",
+		zap.String("Query", query),
+		zap.String("SignalName", signalName),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new WorkflowBatchSignalReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	count, err := clientHelper.CountWorkflow(ctx, *request.GetNamespace(), query)
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	jobID, err := clientHelper.BatchSignal(ctx, *request.GetNamespace(), query, *request.GetReason(), signalName, request.GetSignalArgs())
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	Logger.Info("Batch Signal Started",
+		zap.String("JobId", jobID),
+		zap.Int64("MatchedWorkflows", count))
+
+	reply.Build(nil, jobID)
+
+	return reply
+}
+
+func handleWorkflowBatchResetRequest(requestCtx context.Context, request *messages.WorkflowBatchResetRequest) messages.IProxyReply {
+	clientID := request.GetClientID()
+	query := *request.GetQuery()
+	Logger.Debug("WorkflowBatchResetRequest Received",
+		zap.String("Query", query),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new WorkflowBatchResetReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	count, err := clientHelper.CountWorkflow(ctx, *request.GetNamespace(), query)
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	resetType := ""
+	if v := request.GetResetType(); v != nil {
+		resetType = *v
+	}
+
+	jobID, err := clientHelper.BatchReset(ctx, *request.GetNamespace(), query, *request.GetReason(), resetType)
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	Logger.Info("Batch Reset Started",
+		zap.String("JobId", jobID),
+		zap.Int64("MatchedWorkflows", count))
+
+	reply.Build(nil, jobID)
+
+	return reply
+}
+
+func handleWorkflowStopBatchJobRequest(requestCtx context.Context, request *messages.WorkflowStopBatchJobRequest) messages.IProxyReply {
+	clientID := request.GetClientID()
+	jobID := *request.GetJobID()
+	Logger.Debug("WorkflowStopBatchJobRequest Received",
+		zap.String("JobId", jobID),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new WorkflowStopBatchJobReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	reason := ""
+	if v := request.GetReason(); v != nil {
+		reason = *v
+	}
+
+	err := clientHelper.StopBatchJob(ctx, jobID, reason)
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	reply.Build(nil)
+
+	return reply
+}
+
+func handleWorkflowDescribeBatchJobRequest(requestCtx context.Context, request *messages.WorkflowDescribeBatchJobRequest) messages.IProxyReply {
+	clientID := request.GetClientID()
+	jobID := *request.GetJobID()
+	Logger.Debug("WorkflowDescribeBatchJobRequest Received",
+		zap.String("JobId", jobID),
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new WorkflowDescribeBatchJobReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	description, err := clientHelper.DescribeBatchJob(ctx, jobID)
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	reply.Build(nil, description)
+
+	return reply
+}
+
+func handleWorkflowListBatchJobsRequest(requestCtx context.Context, request *messages.WorkflowListBatchJobsRequest) messages.IProxyReply {
+	clientID := request.GetClientID()
+	Logger.Debug("WorkflowListBatchJobsRequest Received",
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new WorkflowListBatchJobsReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	// create the context
+	ctx, cancel := context.WithTimeout(requestCtx, clientHelper.GetClientTimeout())
+	defer cancel()
+
+	jobs, err := clientHelper.ListBatchJobs(ctx, *request.GetNamespace())
+	if err != nil {
+		reply.Build(err)
+		return reply
+	}
+
+	reply.Build(nil, jobs)
+
+	return reply
+}
+
+func handleWorkflowSetRetryPolicyRequest(requestCtx context.Context, request *messages.WorkflowSetRetryPolicyRequest) messages.IProxyReply {
+	clientID := request.GetClientID()
+	Logger.Debug("WorkflowSetRetryPolicyRequest Received",
+		zap.Int64("ClientId", clientID),
+		zap.Int64("RequestId", request.GetRequestID()),
+		zap.Int("ProcessId", os.Getpid()))
+
+	// new WorkflowSetRetryPolicyReply
+	reply := messages.CreateReplyMessage(request)
+
+	clientHelper := Clients.Get(clientID)
+	if clientHelper == nil {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	retryableHelper, ok := clientHelper.(*proxyclient.RetryableClientHelper)
+	if !ok {
+		reply.Build(internal.ErrEntityNotExist)
+		return reply
+	}
+
+	retryableHelper.SetRetryPolicy(request.GetRetryPolicy())
+
+	Logger.Info("Updated RetryPolicy for client", zap.Int64("ClientId", clientID))
+
+	reply.Build(nil)
+
+	return reply
+}
+
 func handleWorkflowQueueCloseRequest(requestCtx context.Context, request *messages.WorkflowQueueCloseRequest) messages.IProxyReply {
 	contextID := request.GetContextID()
 	queueID := request.GetQueueID()
@@ -1461,3 +2338,45 @@ func handleWorkflowQueueCloseRequest(requestCtx context.Context, request *messag
 
 	return reply
 }
+
+func init() {
+	RegisterHandler(internal.WorkflowExecuteRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleWorkflowExecuteRequest(requestCtx, request.(*messages.WorkflowExecuteRequest))
+	})
+	RegisterHandler(internal.WorkflowBatchTerminateRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleWorkflowBatchTerminateRequest(requestCtx, request.(*messages.WorkflowBatchTerminateRequest))
+	})
+	RegisterHandler(internal.WorkflowBatchCancelRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleWorkflowBatchCancelRequest(requestCtx, request.(*messages.WorkflowBatchCancelRequest))
+	})
+	RegisterHandler(internal.WorkflowBatchSignalRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleWorkflowBatchSignalRequest(requestCtx, request.(*messages.WorkflowBatchSignalRequest))
+	})
+	RegisterHandler(internal.WorkflowBatchResetRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleWorkflowBatchResetRequest(requestCtx, request.(*messages.WorkflowBatchResetRequest))
+	})
+	RegisterHandler(internal.WorkflowStopBatchJobRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleWorkflowStopBatchJobRequest(requestCtx, request.(*messages.WorkflowStopBatchJobRequest))
+	})
+	RegisterHandler(internal.WorkflowDescribeBatchJobRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleWorkflowDescribeBatchJobRequest(requestCtx, request.(*messages.WorkflowDescribeBatchJobRequest))
+	})
+	RegisterHandler(internal.WorkflowListBatchJobsRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleWorkflowListBatchJobsRequest(requestCtx, request.(*messages.WorkflowListBatchJobsRequest))
+	})
+	RegisterHandler(internal.WorkflowUpdateRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleWorkflowUpdateRequest(requestCtx, request.(*messages.WorkflowUpdateRequest))
+	})
+	RegisterHandler(internal.WorkflowUpdateWithStartRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleWorkflowUpdateWithStartRequest(requestCtx, request.(*messages.WorkflowUpdateWithStartRequest))
+	})
+	RegisterHandler(internal.WorkflowSetUpdateHandlerRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleWorkflowSetUpdateHandlerRequest(requestCtx, request.(*messages.WorkflowSetUpdateHandlerRequest))
+	})
+	RegisterHandler(internal.WorkflowDetachChildRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleWorkflowDetachChildRequest(requestCtx, request.(*messages.WorkflowDetachChildRequest))
+	})
+	RegisterHandler(internal.WorkflowSetRetryPolicyRequest, func(requestCtx context.Context, request messages.IProxyRequest) messages.IProxyReply {
+		return handleWorkflowSetRetryPolicyRequest(requestCtx, request.(*messages.WorkflowSetRetryPolicyRequest))
+	})
+}
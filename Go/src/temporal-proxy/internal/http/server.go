@@ -0,0 +1,175 @@
+//-----------------------------------------------------------------------------
+// FILE:		server.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package http exposes the proxy's message handlers over plain HTTP/JSON
+// POST requests, as an alternative to the raw framed socket the binary
+// transport expects a Neon.Temporal client to speak. It's meant for
+// clients for which that socket is impractical to open directly -- a
+// browser, a client sitting behind an HTTP-only proxy, or a sidecar
+// topology -- and for driving the handlers in internal/handlers from
+// Go's own net/http/httptest in place of a real socket.
+//
+// Every route here decodes its body with messages.Deserialize and routes
+// the result through handlers.Dispatch, so none of handlers.handleXxx
+// are duplicated -- this package is just another way to get a decoded
+// IProxyRequest to the same Dispatcher the binary transport's eventual
+// dispatch loop will use.
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"net/http"
+
+	"temporal-proxy/internal/handlers"
+	"temporal-proxy/internal/messages"
+	"temporal-proxy/internal/transport/proxyproto"
+)
+
+// Server is an http.Handler exposing handlers.Dispatch over the POST
+// routes /workflow/execute, /activity/execute, and /terminate.
+//
+// NOTE: Connect, DomainRegister, DomainDescribe, DomainUpdate, and
+// Heartbeat have no corresponding message type or handler in this
+// snapshot yet (the ClientHelper methods a Domain/Namespace handler
+// would wrap -- RegisterNamespace, DescribeNamespace, UpdateNamespace --
+// already exist on internal/temporal/client.ClientHelper, see
+// helper.go), so no route is registered for them below. Adding one is a
+// matter of registering another route here once that message type and a
+// handlers.RegisterHandler call for it exist.
+type Server struct {
+	mux *http.ServeMux
+
+	// TLSConfig, if set, makes ListenAndServe require and verify a
+	// client certificate on every connection before it reaches mux,
+	// e.g. built from a tlsconfig.Reloader's ServerTLSConfig.
+	TLSConfig *tls.Config
+
+	// ProxyProtocol, if true, makes ListenAndServe parse a PROXY
+	// protocol v2 preamble off each connection (see the proxyproto
+	// package) before TLSConfig's handshake, so the real client address
+	// survives an L4 load balancer sitting in front of the proxy.
+	ProxyProtocol bool
+}
+
+// NewServer is the default constructor for a Server. It registers the
+// /workflow/execute, /activity/execute, and /terminate routes; no other
+// message type has a route of its own.
+//
+// returns *Server -> a pointer to a new Server in memory.
+func NewServer() *Server {
+	server := &Server{
+		mux: http.NewServeMux(),
+	}
+
+	server.mux.HandleFunc("/workflow/execute", server.handleMessage)
+	server.mux.HandleFunc("/activity/execute", server.handleMessage)
+	server.mux.HandleFunc("/terminate", server.handleMessage)
+
+	return server
+}
+
+// ServeHTTP inherits docs from http.Handler.ServeHTTP.
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mux.ServeHTTP(w, r)
+}
+
+// handleMessage is shared by every route registered in NewServer: it
+// decodes r's body into an IProxyRequest with messages.Deserialize,
+// routes it through handlers.Dispatch, and writes the resulting reply
+// back serialized the same way the request came in. Which route served
+// the request doesn't change how it's handled -- messages.Deserialize
+// determines the concrete message type from the body itself, the same
+// way the binary transport's reply demuxing does -- so the routes above
+// exist for a caller's convenience, not because this handler needs them.
+func (server *Server) handleMessage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	message, err := messages.Deserialize(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	request, ok := message.(messages.IProxyRequest)
+	if !ok {
+		http.Error(w, "message is not a request", http.StatusBadRequest)
+		return
+	}
+
+	reply := handlers.Dispatch(r.Context(), request)
+
+	data, err := messages.Serialize(reply)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+	_, _ = w.Write(data)
+}
+
+// ListenAndServe starts the Server listening on addr. It blocks until
+// ctx is cancelled or the underlying http.Server returns an error other
+// than http.ErrServerClosed.
+//
+// params:
+//	- ctx context.Context -> cancelled to shut the Server down cleanly.
+// 	- addr string -> the address to listen on, e.g. ":8088".
+//
+// returns error -> any error returned by the underlying http.Server,
+// other than http.ErrServerClosed.
+func (server *Server) ListenAndServe(ctx context.Context, addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	if server.ProxyProtocol {
+		lis = proxyproto.NewListener(lis)
+	}
+
+	if server.TLSConfig != nil {
+		lis = tls.NewListener(lis, server.TLSConfig)
+	}
+
+	httpServer := &http.Server{Handler: server}
+
+	go func() {
+		<-ctx.Done()
+		_ = httpServer.Close()
+	}()
+
+	err = httpServer.Serve(lis)
+	if err == http.ErrServerClosed {
+		return nil
+	}
+
+	return err
+}
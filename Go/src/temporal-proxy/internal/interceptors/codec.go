@@ -0,0 +1,82 @@
+//-----------------------------------------------------------------------------
+// FILE:		codec.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/workflow"
+)
+
+type (
+
+	// PayloadCodecInterceptor is a reference WorkflowOutboundInterceptor
+	// and ClientInterceptor that runs a Call's Args through Codec before
+	// it leaves the proxy, so payloads can be encrypted, compressed, or
+	// otherwise transformed independently of the requesting client's
+	// DataConverter.  Decoding the matching reply/result payload back
+	// into plaintext is the caller's responsibility, via Codec.Decode.
+	PayloadCodecInterceptor struct {
+		Codec converter.PayloadCodec
+	}
+)
+
+// NewPayloadCodecInterceptor is the default constructor for a
+// PayloadCodecInterceptor.
+//
+// param codec converter.PayloadCodec -> the codec to apply to
+// intercepted payloads.
+//
+// returns *PayloadCodecInterceptor -> a reference to a newly
+// initialized PayloadCodecInterceptor wrapping codec.
+func NewPayloadCodecInterceptor(codec converter.PayloadCodec) *PayloadCodecInterceptor {
+	return &PayloadCodecInterceptor{
+		Codec: codec,
+	}
+}
+
+// InterceptWorkflowOutbound inherits docs from
+// WorkflowOutboundInterceptor.
+//
+// Runs call.Args through Codec.Encode before the call leaves the proxy.
+func (i *PayloadCodecInterceptor) InterceptWorkflowOutbound(ctx workflow.Context, call *Call) error {
+	return i.encode(call)
+}
+
+// InterceptClientCall inherits docs from ClientInterceptor.
+//
+// Runs call.Args through Codec.Encode before the call leaves the proxy.
+func (i *PayloadCodecInterceptor) InterceptClientCall(ctx context.Context, call *Call) error {
+	return i.encode(call)
+}
+
+func (i *PayloadCodecInterceptor) encode(call *Call) error {
+	if call.Args == nil {
+		return nil
+	}
+
+	payloads, err := i.Codec.Encode([]*converter.Payload{{Data: call.Args}})
+	if err != nil {
+		return err
+	}
+
+	call.Args = payloads[0].Data
+
+	return nil
+}
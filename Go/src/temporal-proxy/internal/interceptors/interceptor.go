@@ -0,0 +1,170 @@
+//-----------------------------------------------------------------------------
+// FILE:		interceptor.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package interceptors lets callers observe and adjust the requests the
+// proxy makes on behalf of a workflow (or directly against a Temporal
+// client) without modifying the handlers themselves.  Handlers that
+// cross a boundary worth instrumenting -- a query, a signal or execute
+// against a child workflow, a version check, a queue read/write --
+// build a Call describing what's about to happen and run it through the
+// registered chain before acting on it.
+package interceptors
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/workflow"
+)
+
+type (
+
+	// Call describes a single boundary crossing that interceptors are
+	// given the chance to observe, reject, or rewrite.  Name is the
+	// query/signal/workflow/change name relevant to the call, and Args
+	// is the payload carried across the boundary (encoded query/signal
+	// arguments, workflow input, etc.).  Interceptors that need to
+	// propagate tracing context or other out-of-band data use Header.
+	Call struct {
+		Namespace string
+		ClientID  int64
+		ContextID int64
+		Name      string
+		Args      []byte
+		Header    map[string][]byte
+	}
+
+	// WorkflowInboundInterceptor is invoked for calls that arrive at a
+	// running workflow from the outside -- a registered query handler
+	// being invoked, a version check, a queue read -- before the
+	// proxy acts on the call.
+	WorkflowInboundInterceptor interface {
+		InterceptWorkflowInbound(ctx workflow.Context, call *Call) error
+	}
+
+	// WorkflowOutboundInterceptor is invoked before the proxy issues a
+	// call on behalf of a running workflow -- executing or signaling a
+	// child workflow -- against the Temporal SDK.
+	WorkflowOutboundInterceptor interface {
+		InterceptWorkflowOutbound(ctx workflow.Context, call *Call) error
+	}
+
+	// ClientInterceptor is invoked before the proxy issues a call
+	// directly against a ClientHelper's Temporal client, outside the
+	// context of any running workflow (e.g. WorkflowQueryRequest).
+	ClientInterceptor interface {
+		InterceptClientCall(ctx context.Context, call *Call) error
+	}
+)
+
+var (
+	workflowInboundInterceptors  []WorkflowInboundInterceptor
+	workflowOutboundInterceptors []WorkflowOutboundInterceptor
+	clientInterceptors           []ClientInterceptor
+)
+
+// RegisterWorkflowInterceptor adds interceptor to the chain of workflow
+// interceptors consulted by proxy handlers.  interceptor is appended to
+// the WorkflowInboundInterceptor chain, the WorkflowOutboundInterceptor
+// chain, or both, depending on which interfaces it implements.
+// Interceptors run in registration order and the chain stops at the
+// first one that returns an error.
+//
+// param interceptor interface{} -> the interceptor to register; must
+// implement WorkflowInboundInterceptor, WorkflowOutboundInterceptor, or
+// both.
+func RegisterWorkflowInterceptor(interceptor interface{}) {
+	if in, ok := interceptor.(WorkflowInboundInterceptor); ok {
+		workflowInboundInterceptors = append(workflowInboundInterceptors, in)
+	}
+
+	if out, ok := interceptor.(WorkflowOutboundInterceptor); ok {
+		workflowOutboundInterceptors = append(workflowOutboundInterceptors, out)
+	}
+}
+
+// RegisterClientInterceptor adds interceptor to the chain of
+// ClientInterceptors consulted by proxy handlers that call a
+// ClientHelper's Temporal client directly.  Interceptors run in
+// registration order and the chain stops at the first one that returns
+// an error.
+//
+// param interceptor ClientInterceptor -> the interceptor to register.
+func RegisterClientInterceptor(interceptor ClientInterceptor) {
+	clientInterceptors = append(clientInterceptors, interceptor)
+}
+
+// InterceptWorkflowInbound runs call through every registered
+// WorkflowInboundInterceptor, in registration order, stopping at the
+// first one that returns an error.
+//
+// params:
+//	- ctx workflow.Context -> the context of the workflow the call
+//	  originated at
+//	- call *Call -> describes the boundary crossing being intercepted
+//
+// returns error -> the first error returned by an interceptor in the
+// chain, or nil if every interceptor allowed the call through
+func InterceptWorkflowInbound(ctx workflow.Context, call *Call) error {
+	for _, interceptor := range workflowInboundInterceptors {
+		if err := interceptor.InterceptWorkflowInbound(ctx, call); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InterceptWorkflowOutbound runs call through every registered
+// WorkflowOutboundInterceptor, in registration order, stopping at the
+// first one that returns an error.
+//
+// params:
+//	- ctx workflow.Context -> the context of the workflow issuing the
+//	  call
+//	- call *Call -> describes the boundary crossing being intercepted
+//
+// returns error -> the first error returned by an interceptor in the
+// chain, or nil if every interceptor allowed the call through
+func InterceptWorkflowOutbound(ctx workflow.Context, call *Call) error {
+	for _, interceptor := range workflowOutboundInterceptors {
+		if err := interceptor.InterceptWorkflowOutbound(ctx, call); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InterceptClientCall runs call through every registered
+// ClientInterceptor, in registration order, stopping at the first one
+// that returns an error.
+//
+// params:
+//	- ctx context.Context -> the context the call is being issued under
+//	- call *Call -> describes the boundary crossing being intercepted
+//
+// returns error -> the first error returned by an interceptor in the
+// chain, or nil if every interceptor allowed the call through
+func InterceptClientCall(ctx context.Context, call *Call) error {
+	for _, interceptor := range clientInterceptors {
+		if err := interceptor.InterceptClientCall(ctx, call); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+//-----------------------------------------------------------------------------
+// FILE:		otel.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptors
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/sdk/workflow"
+)
+
+type (
+
+	// headerCarrier adapts a Call's Header map to OTel's
+	// propagation.TextMapCarrier so the registered TextMapPropagator can
+	// read and write span context into it.
+	headerCarrier map[string][]byte
+)
+
+func (c headerCarrier) Get(key string) string {
+	if v, ok := c[key]; ok {
+		return string(v)
+	}
+
+	return ""
+}
+
+func (c headerCarrier) Set(key string, value string) {
+	c[key] = []byte(value)
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// OTelInterceptor is a reference WorkflowInboundInterceptor and
+// WorkflowOutboundInterceptor that propagates an OpenTelemetry span
+// context across the proxy boundary via a Call's Header, so that a
+// trace started by a .NET caller continues across a query, signal, or
+// child workflow execution handled by the proxy.  Tracer is used to
+// start a span for each intercepted inbound call.
+type OTelInterceptor struct {
+	Tracer trace.Tracer
+}
+
+// NewOTelInterceptor is the default constructor for an OTelInterceptor.
+//
+// returns *OTelInterceptor -> a reference to a newly initialized
+// OTelInterceptor using the global OTel TracerProvider.
+func NewOTelInterceptor() *OTelInterceptor {
+	return &OTelInterceptor{
+		Tracer: otel.Tracer("temporal-proxy"),
+	}
+}
+
+// InterceptWorkflowInbound inherits docs from WorkflowInboundInterceptor.
+//
+// Extracts the span context carried in call.Header (if any), starts a
+// child span named after call.Name, and lets the call proceed.
+func (i *OTelInterceptor) InterceptWorkflowInbound(ctx workflow.Context, call *Call) error {
+	propagatedCtx := otel.GetTextMapPropagator().Extract(context.Background(), headerCarrier(call.Header))
+	_, span := i.Tracer.Start(propagatedCtx, call.Name)
+	defer span.End()
+
+	return nil
+}
+
+// InterceptWorkflowOutbound inherits docs from
+// WorkflowOutboundInterceptor.
+//
+// Injects the current span context into call.Header so the receiving
+// child workflow can continue the trace.
+func (i *OTelInterceptor) InterceptWorkflowOutbound(ctx workflow.Context, call *Call) error {
+	if call.Header == nil {
+		call.Header = make(map[string][]byte)
+	}
+
+	otel.GetTextMapPropagator().Inject(context.Background(), headerCarrier(call.Header))
+
+	return nil
+}
+
+var _ propagation.TextMapCarrier = headerCarrier(nil)
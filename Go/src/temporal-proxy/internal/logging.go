@@ -0,0 +1,125 @@
+//-----------------------------------------------------------------------------
+// FILE:		logging.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"os"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+
+	// LogFormatConsole selects zap's human-readable console encoding.
+	LogFormatConsole = "console"
+
+	// LogFormatJSON selects structured JSON logging, with the field
+	// names NewLoggerEncoderConfig assigns, suitable for ingestion by
+	// tools like ELK or Loki.
+	LogFormatJSON = "json"
+)
+
+// NewLoggerEncoderConfig is the zapcore.EncoderConfig NewLogger builds
+// both its console and JSON encoders from. The key names match what
+// ELK/Loki-style JSON log ingestion expects: ts, level, logger, msg.
+func NewLoggerEncoderConfig() zapcore.EncoderConfig {
+	cfg := zap.NewProductionEncoderConfig()
+	cfg.TimeKey = "ts"
+	cfg.LevelKey = "level"
+	cfg.NameKey = "logger"
+	cfg.MessageKey = "msg"
+	cfg.EncodeTime = zapcore.ISO8601TimeEncoder
+	cfg.EncodeLevel = zapcore.LowercaseLevelEncoder
+
+	return cfg
+}
+
+// NewLogger builds a named *zap.Logger honoring the current LogFormat,
+// LogFile, and LogLevel settings. LogLevel is read live by the returned
+// Logger's core, so a later LogLevel.SetLevel call changes its verbosity
+// without rebuilding it.
+//
+// params:
+//	- name string -> the logger's Name(), e.g. ProxyLoggerName or
+//	TemporalLoggerName.
+//
+// returns *zap.Logger -> a new Logger writing to stderr, and additionally
+// to LogFile if one is set.
+func NewLogger(name string) *zap.Logger {
+	encoderConfig := NewLoggerEncoderConfig()
+
+	var encoder zapcore.Encoder
+	if LogFormat == LogFormatJSON {
+		encoder = zapcore.NewJSONEncoder(encoderConfig)
+	} else {
+		encoder = zapcore.NewConsoleEncoder(encoderConfig)
+	}
+
+	sinks := []zapcore.WriteSyncer{zapcore.Lock(os.Stderr)}
+	if LogFile != "" {
+		sinks = append(sinks, zapcore.AddSync(&lumberjack.Logger{
+			Filename:   LogFile,
+			MaxSize:    100, // megabytes
+			MaxBackups: 5,
+			MaxAge:     28, // days
+		}))
+	}
+
+	core := zapcore.NewCore(encoder, zapcore.NewMultiWriteSyncer(sinks...), LogLevel)
+
+	return zap.New(core).Named(name)
+}
+
+// WithWorkflowContext returns logger annotated with the workflow_id and
+// run_id fields of request, so every entry logged through it can be
+// correlated back to the workflow task it was handling.
+//
+// params:
+//	- logger *zap.Logger -> the Logger to annotate.
+//	- workflowID string -> the WorkflowInvokeRequest's WorkflowID.
+//	- runID string -> the WorkflowInvokeRequest's RunID.
+//
+// returns *zap.Logger -> logger.With the workflow_id/run_id fields set.
+func WithWorkflowContext(logger *zap.Logger, workflowID string, runID string) *zap.Logger {
+	return logger.With(
+		zap.String("workflow_id", workflowID),
+		zap.String("run_id", runID))
+}
+
+// WithActivityContext returns logger annotated with the workflow_id,
+// run_id, and activity_id fields of an in-flight activity, so every
+// entry logged through it can be correlated back to the activity
+// execution it was handling.
+//
+// params:
+//	- logger *zap.Logger -> the Logger to annotate.
+//	- workflowID string -> the activity's parent WorkflowID.
+//	- runID string -> the activity's parent RunID.
+//	- activityID string -> the activity's TaskToken or ActivityID.
+//
+// returns *zap.Logger -> logger.With the workflow_id/run_id/activity_id
+// fields set.
+func WithActivityContext(logger *zap.Logger, workflowID string, runID string, activityID string) *zap.Logger {
+	return logger.With(
+		zap.String("workflow_id", workflowID),
+		zap.String("run_id", runID),
+		zap.String("activity_id", activityID))
+}
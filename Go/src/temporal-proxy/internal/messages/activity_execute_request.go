@@ -134,6 +134,31 @@ func (request *ActivityExecuteRequest) SetNamespace(value *string) {
 	request.SetStringProperty("Namespace", value)
 }
 
+// GetHeaders gets a ActivityExecuteRequest's Headers field from its
+// properties map. Headers carries the same caller-supplied context
+// headers (auth tokens, tenant ids, tracing baggage) that were attached
+// to the workflow scheduling this activity, keyed by field name.
+//
+// returns map[string][]byte -> the raw header payloads to propagate to
+// the activity, or nil if none were set.
+func (request *ActivityExecuteRequest) GetHeaders() map[string][]byte {
+	var headers map[string][]byte
+	if err := request.GetJSONProperty("Headers", &headers); err != nil {
+		return nil
+	}
+
+	return headers
+}
+
+// SetHeaders sets a ActivityExecuteRequest's Headers field in its
+// properties map. See GetHeaders for details.
+//
+// param value map[string][]byte -> the raw header payloads to propagate
+// to the activity.
+func (request *ActivityExecuteRequest) SetHeaders(value map[string][]byte) {
+	request.SetJSONProperty("Headers", value)
+}
+
 // -------------------------------------------------------------------------
 // IProxyMessage interface methods for implementing the IProxyMessage interface
 
@@ -154,5 +179,6 @@ func (request *ActivityExecuteRequest) CopyTo(target IProxyMessage) {
 		v.SetOptions(request.GetOptions())
 		v.SetActivity(request.GetActivity())
 		v.SetNamespace(request.GetNamespace())
+		v.SetHeaders(request.GetHeaders())
 	}
 }
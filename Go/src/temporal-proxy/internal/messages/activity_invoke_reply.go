@@ -0,0 +1,93 @@
+//-----------------------------------------------------------------------------
+// FILE:		activity_invoke_reply.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+	proxyerror "temporal-proxy/internal/temporal/error"
+)
+
+type (
+
+	// ActivityInvokeReply is a ActivityReply of MessageType
+	// ActivityInvokeReply.  It holds a reference to a ActivityReply in memory
+	// and is the reply type to an ActivityInvokeRequest, carrying the
+	// result of having run the activity on the Neon.Temporal client.
+	ActivityInvokeReply struct {
+		*ActivityReply
+	}
+)
+
+// NewActivityInvokeReply is the default constructor for
+// a ActivityInvokeReply
+//
+// returns *ActivityInvokeReply -> a pointer to a newly initialized
+// ActivityInvokeReply in memory
+func NewActivityInvokeReply() *ActivityInvokeReply {
+	reply := new(ActivityInvokeReply)
+	reply.ActivityReply = NewActivityReply()
+	reply.SetType(internal.ActivityInvokeReply)
+
+	return reply
+}
+
+// GetResult gets the Activity execution result or nil
+// from a ActivityInvokeReply's properties map.
+//
+// returns []byte -> the activity result encoded as bytes.
+func (reply *ActivityInvokeReply) GetResult() []byte {
+	return reply.GetBytesProperty("Result")
+}
+
+// SetResult sets the Activity execution result or nil
+// in a ActivityInvokeReply's properties map.
+//
+// param value []byte -> the activity result encoded as bytes.
+func (reply *ActivityInvokeReply) SetResult(value []byte) {
+	reply.SetBytesProperty("Result", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Build inherits docs from ActivityReply.Build()
+func (reply *ActivityInvokeReply) Build(e *proxyerror.TemporalError, result ...interface{}) {
+	reply.ActivityReply.Build(e)
+	if len(result) > 0 {
+		if v, ok := result[0].([]byte); ok {
+			reply.SetResult(v)
+		}
+	}
+}
+
+// Clone inherits docs from ProxyMessage.Clone()
+func (reply *ActivityInvokeReply) Clone() IProxyMessage {
+	activityInvokeReply := NewActivityInvokeReply()
+	var messageClone IProxyMessage = activityInvokeReply
+	reply.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyMessage.CopyTo()
+func (reply *ActivityInvokeReply) CopyTo(target IProxyMessage) {
+	reply.ActivityReply.CopyTo(target)
+	if v, ok := target.(*ActivityInvokeReply); ok {
+		v.SetResult(reply.GetResult())
+	}
+}
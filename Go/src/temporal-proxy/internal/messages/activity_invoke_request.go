@@ -0,0 +1,137 @@
+//-----------------------------------------------------------------------------
+// FILE:		activity_invoke_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// ActivityInvokeRequest is an ActivityRequest of MessageType
+	// ActivityInvokeRequest.
+	//
+	// Sent by the proxy to the Neon.Temporal client to ask it to execute
+	// the body of an activity that was registered with
+	// ActivityRegisterRequest.  The client's reply, an ActivityInvokeReply,
+	// is what settles the Operation the registered activity function is
+	// blocked waiting on.
+	ActivityInvokeRequest struct {
+		*ActivityRequest
+	}
+)
+
+// NewActivityInvokeRequest is the default constructor for a ActivityInvokeRequest
+//
+// returns *ActivityInvokeRequest -> a pointer to a newly initialized ActivityInvokeRequest
+// in memory
+func NewActivityInvokeRequest() *ActivityInvokeRequest {
+	request := new(ActivityInvokeRequest)
+	request.ActivityRequest = NewActivityRequest()
+	request.SetType(internal.ActivityInvokeRequest)
+	request.SetReplyType(internal.ActivityInvokeReply)
+
+	return request
+}
+
+// GetActivity gets a ActivityInvokeRequest's Activity field
+// from its properties map.  Specifies the name of the activity to
+// be invoked.
+//
+// returns *string -> *string representing the name of the
+// activity to be invoked
+func (request *ActivityInvokeRequest) GetActivity() *string {
+	return request.GetStringProperty("Activity")
+}
+
+// SetActivity sets an ActivityInvokeRequest's Activity field
+// from its properties map.  Specifies the name of the activity to
+// be invoked.
+//
+// param value *string -> *string representing the name of the
+// activity to be invoked
+func (request *ActivityInvokeRequest) SetActivity(value *string) {
+	request.SetStringProperty("Activity", value)
+}
+
+// GetArgs gets a ActivityInvokeRequest's Args field
+// from its properties map.  Args is a []byte that holds the arguments
+// the activity was scheduled with.
+//
+// returns []byte -> []byte representing the activity's input arguments
+func (request *ActivityInvokeRequest) GetArgs() []byte {
+	return request.GetBytesProperty("Args")
+}
+
+// SetArgs sets an ActivityInvokeRequest's Args field
+// from its properties map.  Args is a []byte that holds the arguments
+// the activity was scheduled with.
+//
+// param value []byte -> []byte representing the activity's input arguments
+func (request *ActivityInvokeRequest) SetArgs(value []byte) {
+	request.SetBytesProperty("Args", value)
+}
+
+// GetHeaders gets a ActivityInvokeRequest's Headers field from its
+// properties map. Headers carries the same caller-supplied context
+// headers (auth tokens, tenant ids, tracing baggage) that were attached
+// to the ActivityExecuteRequest that scheduled this activity, so the
+// Neon.Temporal client can thread them through to whatever invokes the
+// registered activity function.
+//
+// returns map[string][]byte -> the raw header payloads to forward to the
+// client, or nil if none were set.
+func (request *ActivityInvokeRequest) GetHeaders() map[string][]byte {
+	var headers map[string][]byte
+	if err := request.GetJSONProperty("Headers", &headers); err != nil {
+		return nil
+	}
+
+	return headers
+}
+
+// SetHeaders sets a ActivityInvokeRequest's Headers field in its
+// properties map. See GetHeaders for details.
+//
+// param value map[string][]byte -> the raw header payloads to forward to
+// the client.
+func (request *ActivityInvokeRequest) SetHeaders(value map[string][]byte) {
+	request.SetJSONProperty("Headers", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ActivityRequest.Clone()
+func (request *ActivityInvokeRequest) Clone() IProxyMessage {
+	activityInvokeRequest := NewActivityInvokeRequest()
+	var messageClone IProxyMessage = activityInvokeRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ActivityRequest.CopyTo()
+func (request *ActivityInvokeRequest) CopyTo(target IProxyMessage) {
+	request.ActivityRequest.CopyTo(target)
+	if v, ok := target.(*ActivityInvokeRequest); ok {
+		v.SetActivity(request.GetActivity())
+		v.SetArgs(request.GetArgs())
+		v.SetHeaders(request.GetHeaders())
+	}
+}
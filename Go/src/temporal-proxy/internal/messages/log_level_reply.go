@@ -0,0 +1,56 @@
+//-----------------------------------------------------------------------------
+// FILE:		log_level_reply.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+type (
+
+	// LogLevelReply is a ProxyReply of MessageType LogLevelReply.
+	//
+	// A LogLevelReply is sent in response to a LogLevelRequest.
+	LogLevelReply struct {
+		*ProxyReply
+	}
+)
+
+// NewLogLevelReply is the default constructor for a LogLevelReply.
+//
+// returns *LogLevelReply -> a reference to a newly initialized
+// LogLevelReply in memory
+func NewLogLevelReply() *LogLevelReply {
+	reply := new(LogLevelReply)
+	reply.ProxyReply = NewProxyReply()
+
+	return reply
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyReply.Clone()
+func (reply *LogLevelReply) Clone() IProxyMessage {
+	logLevelReply := NewLogLevelReply()
+	var messageClone IProxyMessage = logLevelReply
+	reply.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyReply.CopyTo()
+func (reply *LogLevelReply) CopyTo(target IProxyMessage) {
+	reply.ProxyReply.CopyTo(target)
+}
@@ -0,0 +1,85 @@
+//-----------------------------------------------------------------------------
+// FILE:		log_level_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// LogLevelRequest is a ProxyRequest of MessageType LogLevelRequest.
+	//
+	// A LogLevelRequest asks the proxy to change its global log level at
+	// runtime, so a Neon.Temporal client can turn proxy verbosity up or
+	// down without restarting the proxy process.
+	LogLevelRequest struct {
+		*ProxyRequest
+	}
+)
+
+// NewLogLevelRequest is the default constructor for a LogLevelRequest
+//
+// returns *LogLevelRequest -> a reference to a newly initialized
+// LogLevelRequest in memory
+func NewLogLevelRequest() *LogLevelRequest {
+	request := new(LogLevelRequest)
+	request.ProxyRequest = NewProxyRequest()
+	request.SetType(internal.LogLevelRequest)
+	request.SetReplyType(internal.LogLevelReply)
+
+	return request
+}
+
+// GetLogLevel gets a LogLevelRequest's LogLevel field from its
+// properties map. LogLevel is the name of the zapcore.Level to switch
+// the proxy's loggers to, e.g. "debug", "info", "warn", "error".
+//
+// returns *string -> *string representing the requested log level name.
+func (request *LogLevelRequest) GetLogLevel() *string {
+	return request.GetStringProperty("LogLevel")
+}
+
+// SetLogLevel sets a LogLevelRequest's LogLevel field in its properties
+// map. See GetLogLevel for details.
+//
+// param value *string -> *string representing the requested log level
+// name.
+func (request *LogLevelRequest) SetLogLevel(value *string) {
+	request.SetStringProperty("LogLevel", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyRequest.Clone()
+func (request *LogLevelRequest) Clone() IProxyMessage {
+	logLevelRequest := NewLogLevelRequest()
+	var messageClone IProxyMessage = logLevelRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyRequest.CopyTo()
+func (request *LogLevelRequest) CopyTo(target IProxyMessage) {
+	request.ProxyRequest.CopyTo(target)
+	if v, ok := target.(*LogLevelRequest); ok {
+		v.SetLogLevel(request.GetLogLevel())
+	}
+}
@@ -0,0 +1,139 @@
+//-----------------------------------------------------------------------------
+// FILE:		proxy_progress.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// IProxyProgress is implemented by every interim progress frame a
+	// handler may emit for a RequestID while the ProxyRequest it
+	// belongs to is still running, ahead of that request's terminal
+	// IProxyReply. Modeled on BuildKit's progress protocol, a
+	// long-running operation (a batch job, a worker drain, an activity
+	// with heartbeat detail worth surfacing) reports its progress as a
+	// sequence of ProxyProgressVertex/ProxyProgressStatus/
+	// ProxyProgressLog frames sharing one RequestID, each numbered by
+	// Sequence so the receiver can detect a dropped or reordered frame,
+	// with the last frame in the sequence carrying Done true.
+	//
+	// NOTE: nothing in this snapshot emits or consumes an
+	// IProxyProgress yet. Delivering one requires a chunked HTTP
+	// response or a multiplexed connection that outlives a single
+	// request/reply round trip -- transport.StreamTransport already
+	// provides that framing, but the request/reply dispatch loop it's
+	// meant to plug into isn't present here either (see the NOTE on
+	// package transport). These types exist so a handler and its
+	// Transport have a shared frame shape to build against once that
+	// loop exists; there is deliberately no call site added here.
+	IProxyProgress interface {
+		IProxyMessage
+		GetSequence() int64
+		SetSequence(value int64)
+		GetDone() bool
+		SetDone(value bool)
+	}
+
+	// ProxyProgress is the IProxyMessage type embedded by every
+	// concrete progress frame type (ProxyProgressVertex,
+	// ProxyProgressStatus, ProxyProgressLog), holding the
+	// Sequence/Done bookkeeping common to all three. A frame's
+	// RequestID, inherited from ProxyMessage, correlates it back to the
+	// ProxyRequest whose ProxyReply.Stream flag is set.
+	ProxyProgress struct {
+		*ProxyMessage
+	}
+)
+
+// NewProxyProgress is the default constructor for ProxyProgress.
+//
+// returns *ProxyProgress -> a pointer to a new ProxyProgress in memory.
+func NewProxyProgress() *ProxyProgress {
+	progress := new(ProxyProgress)
+	progress.ProxyMessage = NewProxyMessage()
+	progress.SetType(internal.Unspecified)
+
+	return progress
+}
+
+// GetSequence gets the monotonically increasing position of this frame
+// within its RequestID's progress stream, starting at 1, from a
+// ProxyProgress's properties map.
+//
+// returns int64 -> this frame's position in its progress stream.
+func (progress *ProxyProgress) GetSequence() int64 {
+	return int64(progress.GetIntProperty("Sequence"))
+}
+
+// SetSequence sets the monotonically increasing position of this frame
+// within its RequestID's progress stream, starting at 1, in a
+// ProxyProgress's properties map.
+//
+// param value int64 -> this frame's position in its progress stream.
+func (progress *ProxyProgress) SetSequence(value int64) {
+	progress.SetIntProperty("Sequence", int(value))
+}
+
+// GetDone gets the Done flag from a ProxyProgress's properties map,
+// true on the last frame of its RequestID's progress stream -- the
+// terminal IProxyReply for the same RequestID follows immediately
+// after.
+//
+// returns bool -> true if this is the last frame in its progress
+// stream.
+func (progress *ProxyProgress) GetDone() bool {
+	donePtr := progress.GetStringProperty("Done")
+
+	return donePtr != nil && *donePtr == "true"
+}
+
+// SetDone sets the Done flag in a ProxyProgress's properties map. See
+// GetDone.
+//
+// param value bool -> true if this is the last frame in its progress
+// stream.
+func (progress *ProxyProgress) SetDone(value bool) {
+	status := "false"
+	if value {
+		status = "true"
+	}
+	progress.SetStringProperty("Done", &status)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyMessage.Clone()
+func (progress *ProxyProgress) Clone() IProxyMessage {
+	proxyProgress := NewProxyProgress()
+	var messageClone IProxyMessage = proxyProgress
+	progress.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyMessage.CopyTo()
+func (progress *ProxyProgress) CopyTo(target IProxyMessage) {
+	progress.ProxyMessage.CopyTo(target)
+	if v, ok := target.(IProxyProgress); ok {
+		v.SetSequence(progress.GetSequence())
+		v.SetDone(progress.GetDone())
+	}
+}
@@ -0,0 +1,102 @@
+//-----------------------------------------------------------------------------
+// FILE:		proxy_progress_log.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// ProxyProgressLog is a IProxyProgress of MessageType
+	// ProxyProgressLog, carrying one line of log output produced while
+	// a long-running request is executing (e.g. a worker's drain
+	// progress, or an activity's own diagnostic output), mirroring a
+	// vertex log line in BuildKit's progress protocol.
+	ProxyProgressLog struct {
+		*ProxyProgress
+	}
+)
+
+// NewProxyProgressLog is the default constructor for a
+// ProxyProgressLog.
+//
+// returns *ProxyProgressLog -> a pointer to a newly initialized
+// ProxyProgressLog in memory.
+func NewProxyProgressLog() *ProxyProgressLog {
+	log := new(ProxyProgressLog)
+	log.ProxyProgress = NewProxyProgress()
+	log.SetType(internal.ProxyProgressLog)
+
+	return log
+}
+
+// GetLine gets the log line this frame carries, from a ProxyProgressLog's
+// properties map.
+//
+// returns []byte -> the log line, not newline-terminated.
+func (log *ProxyProgressLog) GetLine() []byte {
+	return log.GetBytesProperty("Line")
+}
+
+// SetLine sets the log line this frame carries, in a ProxyProgressLog's
+// properties map.
+//
+// param value []byte -> the log line, not newline-terminated.
+func (log *ProxyProgressLog) SetLine(value []byte) {
+	log.SetBytesProperty("Line", value)
+}
+
+// GetStream gets which output stream ("stdout" or "stderr") this
+// frame's log line was written to, from a ProxyProgressLog's properties
+// map.
+//
+// returns *string -> "stdout" or "stderr".
+func (log *ProxyProgressLog) GetStream() *string {
+	return log.GetStringProperty("Stream")
+}
+
+// SetStream sets which output stream ("stdout" or "stderr") this
+// frame's log line was written to, in a ProxyProgressLog's properties
+// map.
+//
+// param value *string -> "stdout" or "stderr".
+func (log *ProxyProgressLog) SetStream(value *string) {
+	log.SetStringProperty("Stream", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyMessage.Clone()
+func (log *ProxyProgressLog) Clone() IProxyMessage {
+	proxyProgressLog := NewProxyProgressLog()
+	var messageClone IProxyMessage = proxyProgressLog
+	log.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyMessage.CopyTo()
+func (log *ProxyProgressLog) CopyTo(target IProxyMessage) {
+	log.ProxyProgress.CopyTo(target)
+	if v, ok := target.(*ProxyProgressLog); ok {
+		v.SetLine(log.GetLine())
+		v.SetStream(log.GetStream())
+	}
+}
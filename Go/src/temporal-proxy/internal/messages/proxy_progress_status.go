@@ -0,0 +1,119 @@
+//-----------------------------------------------------------------------------
+// FILE:		proxy_progress_status.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// ProxyProgressStatus is a IProxyProgress of MessageType
+	// ProxyProgressStatus, reporting incremental Current/Total progress
+	// for one named unit of work (e.g. activity heartbeat detail, or
+	// "3 of 10 workers drained"), mirroring a vertex status in
+	// BuildKit's progress protocol.
+	ProxyProgressStatus struct {
+		*ProxyProgress
+	}
+)
+
+// NewProxyProgressStatus is the default constructor for a
+// ProxyProgressStatus.
+//
+// returns *ProxyProgressStatus -> a pointer to a newly initialized
+// ProxyProgressStatus in memory.
+func NewProxyProgressStatus() *ProxyProgressStatus {
+	status := new(ProxyProgressStatus)
+	status.ProxyProgress = NewProxyProgress()
+	status.SetType(internal.ProxyProgressStatus)
+
+	return status
+}
+
+// GetName gets the human-readable name of the unit of work this frame
+// reports progress for, from a ProxyProgressStatus's properties map.
+//
+// returns *string -> the name of the unit of work.
+func (status *ProxyProgressStatus) GetName() *string {
+	return status.GetStringProperty("Name")
+}
+
+// SetName sets the human-readable name of the unit of work this frame
+// reports progress for, in a ProxyProgressStatus's properties map.
+//
+// param value *string -> the name of the unit of work.
+func (status *ProxyProgressStatus) SetName(value *string) {
+	status.SetStringProperty("Name", value)
+}
+
+// GetCurrent gets how much of the unit of work this frame reports on
+// has completed so far, from a ProxyProgressStatus's properties map.
+//
+// returns int64 -> the amount of work completed so far.
+func (status *ProxyProgressStatus) GetCurrent() int64 {
+	return int64(status.GetIntProperty("Current"))
+}
+
+// SetCurrent sets how much of the unit of work this frame reports on
+// has completed so far, in a ProxyProgressStatus's properties map.
+//
+// param value int64 -> the amount of work completed so far.
+func (status *ProxyProgressStatus) SetCurrent(value int64) {
+	status.SetIntProperty("Current", int(value))
+}
+
+// GetTotal gets the total amount of work the unit this frame reports on
+// is expected to complete, or 0 if that isn't known yet, from a
+// ProxyProgressStatus's properties map.
+//
+// returns int64 -> the total amount of work expected, or 0 if unknown.
+func (status *ProxyProgressStatus) GetTotal() int64 {
+	return int64(status.GetIntProperty("Total"))
+}
+
+// SetTotal sets the total amount of work the unit this frame reports on
+// is expected to complete, in a ProxyProgressStatus's properties map.
+//
+// param value int64 -> the total amount of work expected, or 0 if
+// unknown.
+func (status *ProxyProgressStatus) SetTotal(value int64) {
+	status.SetIntProperty("Total", int(value))
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyMessage.Clone()
+func (status *ProxyProgressStatus) Clone() IProxyMessage {
+	proxyProgressStatus := NewProxyProgressStatus()
+	var messageClone IProxyMessage = proxyProgressStatus
+	status.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyMessage.CopyTo()
+func (status *ProxyProgressStatus) CopyTo(target IProxyMessage) {
+	status.ProxyProgress.CopyTo(target)
+	if v, ok := target.(*ProxyProgressStatus); ok {
+		v.SetName(status.GetName())
+		v.SetCurrent(status.GetCurrent())
+		v.SetTotal(status.GetTotal())
+	}
+}
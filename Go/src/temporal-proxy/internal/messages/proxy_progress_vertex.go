@@ -0,0 +1,127 @@
+//-----------------------------------------------------------------------------
+// FILE:		proxy_progress_vertex.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// ProxyProgressVertex is a IProxyProgress of MessageType
+	// ProxyProgressVertex, reporting that one unit of work in a
+	// long-running request's execution graph -- a batch item, a child
+	// workflow, a draining worker -- has started or finished, mirroring
+	// a vertex in BuildKit's progress protocol.
+	ProxyProgressVertex struct {
+		*ProxyProgress
+	}
+)
+
+// NewProxyProgressVertex is the default constructor for a
+// ProxyProgressVertex.
+//
+// returns *ProxyProgressVertex -> a pointer to a newly initialized
+// ProxyProgressVertex in memory.
+func NewProxyProgressVertex() *ProxyProgressVertex {
+	vertex := new(ProxyProgressVertex)
+	vertex.ProxyProgress = NewProxyProgress()
+	vertex.SetType(internal.ProxyProgressVertex)
+
+	return vertex
+}
+
+// GetName gets the human-readable name of the vertex this frame reports
+// on (e.g. the batch item's WorkflowID, or the worker's TaskQueue) from
+// a ProxyProgressVertex's properties map.
+//
+// returns *string -> the vertex's name.
+func (vertex *ProxyProgressVertex) GetName() *string {
+	return vertex.GetStringProperty("Name")
+}
+
+// SetName sets the human-readable name of the vertex this frame reports
+// on in a ProxyProgressVertex's properties map.
+//
+// param value *string -> the vertex's name.
+func (vertex *ProxyProgressVertex) SetName(value *string) {
+	vertex.SetStringProperty("Name", value)
+}
+
+// GetCompleted gets whether the vertex this frame reports on has
+// finished, from a ProxyProgressVertex's properties map. False means
+// this frame is reporting that the vertex has started instead.
+//
+// returns bool -> true once the vertex has finished.
+func (vertex *ProxyProgressVertex) GetCompleted() bool {
+	completedPtr := vertex.GetStringProperty("Completed")
+
+	return completedPtr != nil && *completedPtr == "true"
+}
+
+// SetCompleted sets whether the vertex this frame reports on has
+// finished, in a ProxyProgressVertex's properties map. See
+// GetCompleted.
+//
+// param value bool -> true once the vertex has finished.
+func (vertex *ProxyProgressVertex) SetCompleted(value bool) {
+	status := "false"
+	if value {
+		status = "true"
+	}
+	vertex.SetStringProperty("Completed", &status)
+}
+
+// GetError gets the error the vertex this frame reports on failed
+// with, or nil if it succeeded (or hasn't completed yet), from a
+// ProxyProgressVertex's properties map.
+//
+// returns *string -> the vertex's failure message, if any.
+func (vertex *ProxyProgressVertex) GetError() *string {
+	return vertex.GetStringProperty("Error")
+}
+
+// SetError sets the error the vertex this frame reports on failed
+// with, in a ProxyProgressVertex's properties map.
+//
+// param value *string -> the vertex's failure message, if any.
+func (vertex *ProxyProgressVertex) SetError(value *string) {
+	vertex.SetStringProperty("Error", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyMessage.Clone()
+func (vertex *ProxyProgressVertex) Clone() IProxyMessage {
+	proxyProgressVertex := NewProxyProgressVertex()
+	var messageClone IProxyMessage = proxyProgressVertex
+	vertex.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyMessage.CopyTo()
+func (vertex *ProxyProgressVertex) CopyTo(target IProxyMessage) {
+	vertex.ProxyProgress.CopyTo(target)
+	if v, ok := target.(*ProxyProgressVertex); ok {
+		v.SetName(vertex.GetName())
+		v.SetCompleted(vertex.GetCompleted())
+		v.SetError(vertex.GetError())
+	}
+}
@@ -38,6 +38,12 @@ type (
 		IProxyMessage
 		GetError() error
 		SetError(value error)
+		GetHeaders() map[string][]string
+		SetHeaders(value map[string][]string)
+		GetReplayStatus() internal.ReplayStatus
+		SetReplayStatus(value internal.ReplayStatus)
+		GetStream() bool
+		SetStream(value bool)
 		Build(e error, content ...interface{})
 	}
 )
@@ -86,6 +92,92 @@ func (reply *ProxyReply) SetError(value error) {
 	reply.SetJSONProperty("Error", internal.NewTemporalError(value))
 }
 
+// GetHeaders gets the gRPC metadata headers the Temporal server returned
+// while handling this reply's request (e.g. response trailers), encoded
+// as a JSON string→[]string map in a ProxyReply's Properties map. Nil
+// when header forwarding was disabled or the server returned none.
+//
+// returns map[string][]string -> the gRPC metadata headers captured
+// from the Temporal server's response
+func (reply *ProxyReply) GetHeaders() map[string][]string {
+	var headers map[string][]string
+	reply.GetJSONProperty("Headers", &headers)
+
+	return headers
+}
+
+// SetHeaders sets the gRPC metadata headers the Temporal server returned
+// while handling this reply's request, encoded as a JSON string→[]string
+// map in a ProxyReply's Properties map, so they can be surfaced back to
+// the Neon.Temporal client (auth tokens, tracing baggage, tenant ids).
+//
+// param value map[string][]string -> the gRPC metadata headers to set
+// in the properties map
+func (reply *ProxyReply) SetHeaders(value map[string][]string) {
+	reply.SetJSONProperty("Headers", value)
+}
+
+// GetReplayStatus gets the ReplayStatus from a ProxyReply's properties
+// map, indicating whether the handler that produced this reply observed
+// the owning workflow execution as replaying history or executing it
+// for the first time.
+//
+// returns internal.ReplayStatus -> the current history replay state of
+// the workflow the reply belongs to.
+func (reply *ProxyReply) GetReplayStatus() internal.ReplayStatus {
+	replayStatusPtr := reply.GetStringProperty("ReplayStatus")
+	if replayStatusPtr == nil {
+		return internal.ReplayStatusUnspecified
+	}
+	replayStatus := internal.StringToReplayStatus(*replayStatusPtr)
+
+	return replayStatus
+}
+
+// SetReplayStatus sets the ReplayStatus in a ProxyReply's properties map,
+// indicating whether the handler that produced this reply observed the
+// owning workflow execution as replaying history or executing it for the
+// first time.
+//
+// param value internal.ReplayStatus -> the current history replay state
+// of the workflow the reply belongs to.
+func (reply *ProxyReply) SetReplayStatus(value internal.ReplayStatus) {
+	status := value.String()
+	reply.SetStringProperty("ReplayStatus", &status)
+}
+
+// GetStream gets the Stream flag from a ProxyReply's properties map.
+// When true, the handler that produced this reply emits a sequence of
+// IProxyProgress frames (see ProxyProgress) for its RequestID before
+// this reply, its terminal message, closes the sequence -- letting the
+// Neon.Temporal client show live progress for a long-running request
+// instead of blocking on a single opaque call.
+//
+// NOTE: nothing in this snapshot sets this true yet; see the NOTE on
+// IProxyProgress for why emitting the frames it promises requires
+// dispatch-loop wiring this snapshot doesn't have.
+//
+// returns bool -> true if interim IProxyProgress frames precede this
+// reply.
+func (reply *ProxyReply) GetStream() bool {
+	streamPtr := reply.GetStringProperty("Stream")
+
+	return streamPtr != nil && *streamPtr == "true"
+}
+
+// SetStream sets the Stream flag in a ProxyReply's properties map. See
+// GetStream.
+//
+// param value bool -> true if interim IProxyProgress frames precede
+// this reply.
+func (reply *ProxyReply) SetStream(value bool) {
+	status := "false"
+	if value {
+		status = "true"
+	}
+	reply.SetStringProperty("Stream", &status)
+}
+
 // Build build the IProxyReply given specified results.
 //
 // params:
@@ -112,5 +204,8 @@ func (reply *ProxyReply) CopyTo(target IProxyMessage) {
 	reply.ProxyMessage.CopyTo(target)
 	if v, ok := target.(IProxyReply); ok {
 		v.SetError(reply.GetError())
+		v.SetHeaders(reply.GetHeaders())
+		v.SetReplayStatus(reply.GetReplayStatus())
+		v.SetStream(reply.GetStream())
 	}
 }
@@ -0,0 +1,174 @@
+//-----------------------------------------------------------------------------
+// FILE:		schedule_backfill_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/client"
+
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// ScheduleBackfillRequest is a ProxyRequest of MessageType
+	// ScheduleBackfillRequest.
+	//
+	// A ScheduleBackfillRequest contains a reference to a
+	// ProxyRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this ProxyRequest
+	//
+	// Backfills a Temporal schedule by executing its action as though it
+	// had fired at every scheduled time within [StartTime, EndTime],
+	// subject to OverlapPolicy.
+	ScheduleBackfillRequest struct {
+		*ProxyRequest
+	}
+)
+
+// NewScheduleBackfillRequest is the default constructor for a ScheduleBackfillRequest
+//
+// returns *ScheduleBackfillRequest -> a reference to a newly initialized
+// ScheduleBackfillRequest in memory
+func NewScheduleBackfillRequest() *ScheduleBackfillRequest {
+	request := new(ScheduleBackfillRequest)
+	request.ProxyRequest = NewProxyRequest()
+	request.SetType(internal.ScheduleBackfillRequest)
+	request.SetReplyType(internal.ScheduleBackfillReply)
+
+	return request
+}
+
+// GetScheduleID gets a ScheduleBackfillRequest's ScheduleID value
+// from its properties map. Identifies the schedule to be backfilled.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleBackfillRequest's ScheduleID
+func (request *ScheduleBackfillRequest) GetScheduleID() *string {
+	return request.GetStringProperty("ScheduleId")
+}
+
+// SetScheduleID sets a ScheduleBackfillRequest's ScheduleID value
+// in its properties map. Identifies the schedule to be backfilled.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleBackfillRequest) SetScheduleID(value *string) {
+	request.SetStringProperty("ScheduleId", value)
+}
+
+// GetNamespace gets a ScheduleBackfillRequest's Namespace value
+// from its properties map.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleBackfillRequest's Namespace
+func (request *ScheduleBackfillRequest) GetNamespace() *string {
+	return request.GetStringProperty("Namespace")
+}
+
+// SetNamespace sets a ScheduleBackfillRequest's Namespace value
+// in its properties map.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleBackfillRequest) SetNamespace(value *string) {
+	request.SetStringProperty("Namespace", value)
+}
+
+// GetStartTime gets a ScheduleBackfillRequest's StartTime value from
+// its properties map. The start of the time range to backfill, inclusive.
+//
+// returns time.Time -> the start of the backfill range.
+func (request *ScheduleBackfillRequest) GetStartTime() time.Time {
+	var startTime time.Time
+	request.GetJSONProperty("StartTime", &startTime)
+
+	return startTime
+}
+
+// SetStartTime sets a ScheduleBackfillRequest's StartTime value in
+// its properties map. The start of the time range to backfill, inclusive.
+//
+// param value time.Time -> the start of the backfill range.
+func (request *ScheduleBackfillRequest) SetStartTime(value time.Time) {
+	request.SetJSONProperty("StartTime", value)
+}
+
+// GetEndTime gets a ScheduleBackfillRequest's EndTime value from
+// its properties map. The end of the time range to backfill, inclusive.
+//
+// returns time.Time -> the end of the backfill range.
+func (request *ScheduleBackfillRequest) GetEndTime() time.Time {
+	var endTime time.Time
+	request.GetJSONProperty("EndTime", &endTime)
+
+	return endTime
+}
+
+// SetEndTime sets a ScheduleBackfillRequest's EndTime value in its
+// properties map. The end of the time range to backfill, inclusive.
+//
+// param value time.Time -> the end of the backfill range.
+func (request *ScheduleBackfillRequest) SetEndTime(value time.Time) {
+	request.SetJSONProperty("EndTime", value)
+}
+
+// GetOverlapPolicy gets a ScheduleBackfillRequest's OverlapPolicy
+// value from its properties map. Controls whether backfilled runs are
+// allowed to overlap with each other or a currently running action.
+//
+// returns client.ScheduleOverlapPolicy -> the overlap policy to apply
+// to the backfilled runs.
+func (request *ScheduleBackfillRequest) GetOverlapPolicy() client.ScheduleOverlapPolicy {
+	return client.ScheduleOverlapPolicy(request.GetIntProperty("OverlapPolicy"))
+}
+
+// SetOverlapPolicy sets a ScheduleBackfillRequest's OverlapPolicy
+// value in its properties map. Controls whether backfilled runs are
+// allowed to overlap with each other or a currently running action.
+//
+// param value client.ScheduleOverlapPolicy -> the overlap policy to
+// apply to the backfilled runs.
+func (request *ScheduleBackfillRequest) SetOverlapPolicy(value client.ScheduleOverlapPolicy) {
+	request.SetIntProperty("OverlapPolicy", int32(value))
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyRequest.Clone()
+func (request *ScheduleBackfillRequest) Clone() IProxyMessage {
+	scheduleBackfillRequest := NewScheduleBackfillRequest()
+	var messageClone IProxyMessage = scheduleBackfillRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyRequest.CopyTo()
+func (request *ScheduleBackfillRequest) CopyTo(target IProxyMessage) {
+	request.ProxyRequest.CopyTo(target)
+	if v, ok := target.(*ScheduleBackfillRequest); ok {
+		v.SetScheduleID(request.GetScheduleID())
+		v.SetNamespace(request.GetNamespace())
+		v.SetStartTime(request.GetStartTime())
+		v.SetEndTime(request.GetEndTime())
+		v.SetOverlapPolicy(request.GetOverlapPolicy())
+	}
+}
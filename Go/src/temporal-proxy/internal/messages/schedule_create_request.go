@@ -0,0 +1,137 @@
+//-----------------------------------------------------------------------------
+// FILE:		schedule_create_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	"go.temporal.io/sdk/client"
+
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// ScheduleCreateRequest is a ProxyRequest of MessageType
+	// ScheduleCreateRequest.
+	//
+	// A ScheduleCreateRequest contains a reference to a
+	// ProxyRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this ProxyRequest
+	//
+	// Creates a new Temporal schedule that will periodically start
+	// the action described in its options (calendar/interval/cron spec,
+	// overlap policy, catchup window).
+	ScheduleCreateRequest struct {
+		*ProxyRequest
+	}
+)
+
+// NewScheduleCreateRequest is the default constructor for a ScheduleCreateRequest
+//
+// returns *ScheduleCreateRequest -> a reference to a newly initialized
+// ScheduleCreateRequest in memory
+func NewScheduleCreateRequest() *ScheduleCreateRequest {
+	request := new(ScheduleCreateRequest)
+	request.ProxyRequest = NewProxyRequest()
+	request.SetType(internal.ScheduleCreateRequest)
+	request.SetReplyType(internal.ScheduleCreateReply)
+
+	return request
+}
+
+// GetScheduleID gets a ScheduleCreateRequest's ScheduleID value
+// from its properties map. Identifies the schedule to be created.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleCreateRequest's ScheduleID
+func (request *ScheduleCreateRequest) GetScheduleID() *string {
+	return request.GetStringProperty("ScheduleId")
+}
+
+// SetScheduleID sets a ScheduleCreateRequest's ScheduleID value
+// in its properties map. Identifies the schedule to be created.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleCreateRequest) SetScheduleID(value *string) {
+	request.SetStringProperty("ScheduleId", value)
+}
+
+// GetNamespace gets a ScheduleCreateRequest's Namespace value
+// from its properties map.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleCreateRequest's Namespace
+func (request *ScheduleCreateRequest) GetNamespace() *string {
+	return request.GetStringProperty("Namespace")
+}
+
+// SetNamespace sets a ScheduleCreateRequest's Namespace value
+// in its properties map.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleCreateRequest) SetNamespace(value *string) {
+	request.SetStringProperty("Namespace", value)
+}
+
+// GetOptions gets a ScheduleCreateRequest's schedule options used to
+// create a temporal schedule via the temporal ScheduleClient. The
+// options carry the calendar/interval/cron spec, the action to start,
+// the overlap policy, and the catchup window.
+//
+// returns *client.ScheduleOptions -> the schedule creation options.
+func (request *ScheduleCreateRequest) GetOptions() *client.ScheduleOptions {
+	opts := new(client.ScheduleOptions)
+	err := request.GetJSONProperty("Options", opts)
+	if err != nil {
+		return nil
+	}
+
+	return opts
+}
+
+// SetOptions sets a ScheduleCreateRequest's schedule options used to
+// create a temporal schedule via the temporal ScheduleClient.
+//
+// param value *client.ScheduleOptions -> the schedule creation options
+// to be set in the properties map.
+func (request *ScheduleCreateRequest) SetOptions(value *client.ScheduleOptions) {
+	request.SetJSONProperty("Options", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyRequest.Clone()
+func (request *ScheduleCreateRequest) Clone() IProxyMessage {
+	scheduleCreateRequest := NewScheduleCreateRequest()
+	var messageClone IProxyMessage = scheduleCreateRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyRequest.CopyTo()
+func (request *ScheduleCreateRequest) CopyTo(target IProxyMessage) {
+	request.ProxyRequest.CopyTo(target)
+	if v, ok := target.(*ScheduleCreateRequest); ok {
+		v.SetScheduleID(request.GetScheduleID())
+		v.SetNamespace(request.GetNamespace())
+		v.SetOptions(request.GetOptions())
+	}
+}
@@ -0,0 +1,107 @@
+//-----------------------------------------------------------------------------
+// FILE:		schedule_delete_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// ScheduleDeleteRequest is a ProxyRequest of MessageType
+	// ScheduleDeleteRequest.
+	//
+	// A ScheduleDeleteRequest contains a reference to a
+	// ProxyRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this ProxyRequest
+	//
+	// Deletes an existing Temporal schedule.
+	ScheduleDeleteRequest struct {
+		*ProxyRequest
+	}
+)
+
+// NewScheduleDeleteRequest is the default constructor for a ScheduleDeleteRequest
+//
+// returns *ScheduleDeleteRequest -> a reference to a newly initialized
+// ScheduleDeleteRequest in memory
+func NewScheduleDeleteRequest() *ScheduleDeleteRequest {
+	request := new(ScheduleDeleteRequest)
+	request.ProxyRequest = NewProxyRequest()
+	request.SetType(internal.ScheduleDeleteRequest)
+	request.SetReplyType(internal.ScheduleDeleteReply)
+
+	return request
+}
+
+// GetScheduleID gets a ScheduleDeleteRequest's ScheduleID value
+// from its properties map. Identifies the schedule to be deleted.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleDeleteRequest's ScheduleID
+func (request *ScheduleDeleteRequest) GetScheduleID() *string {
+	return request.GetStringProperty("ScheduleId")
+}
+
+// SetScheduleID sets a ScheduleDeleteRequest's ScheduleID value
+// in its properties map. Identifies the schedule to be deleted.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleDeleteRequest) SetScheduleID(value *string) {
+	request.SetStringProperty("ScheduleId", value)
+}
+
+// GetNamespace gets a ScheduleDeleteRequest's Namespace value
+// from its properties map.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleDeleteRequest's Namespace
+func (request *ScheduleDeleteRequest) GetNamespace() *string {
+	return request.GetStringProperty("Namespace")
+}
+
+// SetNamespace sets a ScheduleDeleteRequest's Namespace value
+// in its properties map.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleDeleteRequest) SetNamespace(value *string) {
+	request.SetStringProperty("Namespace", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyRequest.Clone()
+func (request *ScheduleDeleteRequest) Clone() IProxyMessage {
+	scheduleDeleteRequest := NewScheduleDeleteRequest()
+	var messageClone IProxyMessage = scheduleDeleteRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyRequest.CopyTo()
+func (request *ScheduleDeleteRequest) CopyTo(target IProxyMessage) {
+	request.ProxyRequest.CopyTo(target)
+	if v, ok := target.(*ScheduleDeleteRequest); ok {
+		v.SetScheduleID(request.GetScheduleID())
+		v.SetNamespace(request.GetNamespace())
+	}
+}
@@ -0,0 +1,108 @@
+//-----------------------------------------------------------------------------
+// FILE:		schedule_describe_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// ScheduleDescribeRequest is a ProxyRequest of MessageType
+	// ScheduleDescribeRequest.
+	//
+	// A ScheduleDescribeRequest contains a reference to a
+	// ProxyRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this ProxyRequest
+	//
+	// Describes an existing Temporal schedule, including its spec,
+	// recent actions, and next scheduled fire times.
+	ScheduleDescribeRequest struct {
+		*ProxyRequest
+	}
+)
+
+// NewScheduleDescribeRequest is the default constructor for a ScheduleDescribeRequest
+//
+// returns *ScheduleDescribeRequest -> a reference to a newly initialized
+// ScheduleDescribeRequest in memory
+func NewScheduleDescribeRequest() *ScheduleDescribeRequest {
+	request := new(ScheduleDescribeRequest)
+	request.ProxyRequest = NewProxyRequest()
+	request.SetType(internal.ScheduleDescribeRequest)
+	request.SetReplyType(internal.ScheduleDescribeReply)
+
+	return request
+}
+
+// GetScheduleID gets a ScheduleDescribeRequest's ScheduleID value
+// from its properties map. Identifies the schedule to be described.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleDescribeRequest's ScheduleID
+func (request *ScheduleDescribeRequest) GetScheduleID() *string {
+	return request.GetStringProperty("ScheduleId")
+}
+
+// SetScheduleID sets a ScheduleDescribeRequest's ScheduleID value
+// in its properties map. Identifies the schedule to be described.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleDescribeRequest) SetScheduleID(value *string) {
+	request.SetStringProperty("ScheduleId", value)
+}
+
+// GetNamespace gets a ScheduleDescribeRequest's Namespace value
+// from its properties map.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleDescribeRequest's Namespace
+func (request *ScheduleDescribeRequest) GetNamespace() *string {
+	return request.GetStringProperty("Namespace")
+}
+
+// SetNamespace sets a ScheduleDescribeRequest's Namespace value
+// in its properties map.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleDescribeRequest) SetNamespace(value *string) {
+	request.SetStringProperty("Namespace", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyRequest.Clone()
+func (request *ScheduleDescribeRequest) Clone() IProxyMessage {
+	scheduleDescribeRequest := NewScheduleDescribeRequest()
+	var messageClone IProxyMessage = scheduleDescribeRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyRequest.CopyTo()
+func (request *ScheduleDescribeRequest) CopyTo(target IProxyMessage) {
+	request.ProxyRequest.CopyTo(target)
+	if v, ok := target.(*ScheduleDescribeRequest); ok {
+		v.SetScheduleID(request.GetScheduleID())
+		v.SetNamespace(request.GetNamespace())
+	}
+}
@@ -0,0 +1,107 @@
+//-----------------------------------------------------------------------------
+// FILE:		schedule_list_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// ScheduleListRequest is a ProxyRequest of MessageType
+	// ScheduleListRequest.
+	//
+	// A ScheduleListRequest contains a reference to a
+	// ProxyRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this ProxyRequest
+	//
+	// Lists the Temporal schedules registered in a namespace.
+	ScheduleListRequest struct {
+		*ProxyRequest
+	}
+)
+
+// NewScheduleListRequest is the default constructor for a ScheduleListRequest
+//
+// returns *ScheduleListRequest -> a reference to a newly initialized
+// ScheduleListRequest in memory
+func NewScheduleListRequest() *ScheduleListRequest {
+	request := new(ScheduleListRequest)
+	request.ProxyRequest = NewProxyRequest()
+	request.SetType(internal.ScheduleListRequest)
+	request.SetReplyType(internal.ScheduleListReply)
+
+	return request
+}
+
+// GetNamespace gets a ScheduleListRequest's Namespace value
+// from its properties map.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleListRequest's Namespace
+func (request *ScheduleListRequest) GetNamespace() *string {
+	return request.GetStringProperty("Namespace")
+}
+
+// SetNamespace sets a ScheduleListRequest's Namespace value
+// in its properties map.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleListRequest) SetNamespace(value *string) {
+	request.SetStringProperty("Namespace", value)
+}
+
+// GetPageSize gets a ScheduleListRequest's PageSize value
+// from its properties map. Limits the number of schedules
+// returned per page.
+//
+// returns int32 -> the maximum number of schedules to return.
+func (request *ScheduleListRequest) GetPageSize() int32 {
+	return request.GetIntProperty("PageSize")
+}
+
+// SetPageSize sets a ScheduleListRequest's PageSize value
+// in its properties map. Limits the number of schedules
+// returned per page.
+//
+// param value int32 -> the maximum number of schedules to return.
+func (request *ScheduleListRequest) SetPageSize(value int32) {
+	request.SetIntProperty("PageSize", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyRequest.Clone()
+func (request *ScheduleListRequest) Clone() IProxyMessage {
+	scheduleListRequest := NewScheduleListRequest()
+	var messageClone IProxyMessage = scheduleListRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyRequest.CopyTo()
+func (request *ScheduleListRequest) CopyTo(target IProxyMessage) {
+	request.ProxyRequest.CopyTo(target)
+	if v, ok := target.(*ScheduleListRequest); ok {
+		v.SetNamespace(request.GetNamespace())
+		v.SetPageSize(request.GetPageSize())
+	}
+}
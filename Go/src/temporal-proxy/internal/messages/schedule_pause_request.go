@@ -0,0 +1,126 @@
+//-----------------------------------------------------------------------------
+// FILE:		schedule_pause_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// SchedulePauseRequest is a ProxyRequest of MessageType
+	// SchedulePauseRequest.
+	//
+	// A SchedulePauseRequest contains a reference to a
+	// ProxyRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this ProxyRequest
+	//
+	// Pauses an existing Temporal schedule so it stops taking new actions.
+	SchedulePauseRequest struct {
+		*ProxyRequest
+	}
+)
+
+// NewSchedulePauseRequest is the default constructor for a SchedulePauseRequest
+//
+// returns *SchedulePauseRequest -> a reference to a newly initialized
+// SchedulePauseRequest in memory
+func NewSchedulePauseRequest() *SchedulePauseRequest {
+	request := new(SchedulePauseRequest)
+	request.ProxyRequest = NewProxyRequest()
+	request.SetType(internal.SchedulePauseRequest)
+	request.SetReplyType(internal.SchedulePauseReply)
+
+	return request
+}
+
+// GetScheduleID gets a SchedulePauseRequest's ScheduleID value
+// from its properties map. Identifies the schedule to be paused.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a SchedulePauseRequest's ScheduleID
+func (request *SchedulePauseRequest) GetScheduleID() *string {
+	return request.GetStringProperty("ScheduleId")
+}
+
+// SetScheduleID sets a SchedulePauseRequest's ScheduleID value
+// in its properties map. Identifies the schedule to be paused.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *SchedulePauseRequest) SetScheduleID(value *string) {
+	request.SetStringProperty("ScheduleId", value)
+}
+
+// GetNamespace gets a SchedulePauseRequest's Namespace value
+// from its properties map.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a SchedulePauseRequest's Namespace
+func (request *SchedulePauseRequest) GetNamespace() *string {
+	return request.GetStringProperty("Namespace")
+}
+
+// SetNamespace sets a SchedulePauseRequest's Namespace value
+// in its properties map.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *SchedulePauseRequest) SetNamespace(value *string) {
+	request.SetStringProperty("Namespace", value)
+}
+
+// GetNote gets a SchedulePauseRequest's Note value from its properties
+// map. The note is recorded on the schedule to explain why it was paused.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a SchedulePauseRequest's Note
+func (request *SchedulePauseRequest) GetNote() *string {
+	return request.GetStringProperty("Note")
+}
+
+// SetNote sets a SchedulePauseRequest's Note value in its properties
+// map. The note is recorded on the schedule to explain why it was paused.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *SchedulePauseRequest) SetNote(value *string) {
+	request.SetStringProperty("Note", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyRequest.Clone()
+func (request *SchedulePauseRequest) Clone() IProxyMessage {
+	schedulePauseRequest := NewSchedulePauseRequest()
+	var messageClone IProxyMessage = schedulePauseRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyRequest.CopyTo()
+func (request *SchedulePauseRequest) CopyTo(target IProxyMessage) {
+	request.ProxyRequest.CopyTo(target)
+	if v, ok := target.(*SchedulePauseRequest); ok {
+		v.SetScheduleID(request.GetScheduleID())
+		v.SetNamespace(request.GetNamespace())
+		v.SetNote(request.GetNote())
+	}
+}
@@ -0,0 +1,131 @@
+//-----------------------------------------------------------------------------
+// FILE:		schedule_trigger_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	"go.temporal.io/sdk/client"
+
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// ScheduleTriggerRequest is a ProxyRequest of MessageType
+	// ScheduleTriggerRequest.
+	//
+	// A ScheduleTriggerRequest contains a reference to a
+	// ProxyRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this ProxyRequest
+	//
+	// Triggers an immediate, out-of-band run of a Temporal schedule's
+	// action, subject to the schedule's overlap policy.
+	ScheduleTriggerRequest struct {
+		*ProxyRequest
+	}
+)
+
+// NewScheduleTriggerRequest is the default constructor for a ScheduleTriggerRequest
+//
+// returns *ScheduleTriggerRequest -> a reference to a newly initialized
+// ScheduleTriggerRequest in memory
+func NewScheduleTriggerRequest() *ScheduleTriggerRequest {
+	request := new(ScheduleTriggerRequest)
+	request.ProxyRequest = NewProxyRequest()
+	request.SetType(internal.ScheduleTriggerRequest)
+	request.SetReplyType(internal.ScheduleTriggerReply)
+
+	return request
+}
+
+// GetScheduleID gets a ScheduleTriggerRequest's ScheduleID value
+// from its properties map. Identifies the schedule to be triggered.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleTriggerRequest's ScheduleID
+func (request *ScheduleTriggerRequest) GetScheduleID() *string {
+	return request.GetStringProperty("ScheduleId")
+}
+
+// SetScheduleID sets a ScheduleTriggerRequest's ScheduleID value
+// in its properties map. Identifies the schedule to be triggered.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleTriggerRequest) SetScheduleID(value *string) {
+	request.SetStringProperty("ScheduleId", value)
+}
+
+// GetNamespace gets a ScheduleTriggerRequest's Namespace value
+// from its properties map.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleTriggerRequest's Namespace
+func (request *ScheduleTriggerRequest) GetNamespace() *string {
+	return request.GetStringProperty("Namespace")
+}
+
+// SetNamespace sets a ScheduleTriggerRequest's Namespace value
+// in its properties map.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleTriggerRequest) SetNamespace(value *string) {
+	request.SetStringProperty("Namespace", value)
+}
+
+// GetOverlapPolicy gets a ScheduleTriggerRequest's OverlapPolicy
+// value from its properties map. Controls whether the triggered
+// run is allowed to overlap with a currently running action.
+//
+// returns client.ScheduleOverlapPolicy -> the overlap policy to apply
+// to the triggered run.
+func (request *ScheduleTriggerRequest) GetOverlapPolicy() client.ScheduleOverlapPolicy {
+	return client.ScheduleOverlapPolicy(request.GetIntProperty("OverlapPolicy"))
+}
+
+// SetOverlapPolicy sets a ScheduleTriggerRequest's OverlapPolicy
+// value in its properties map. Controls whether the triggered
+// run is allowed to overlap with a currently running action.
+//
+// param value client.ScheduleOverlapPolicy -> the overlap policy to
+// apply to the triggered run.
+func (request *ScheduleTriggerRequest) SetOverlapPolicy(value client.ScheduleOverlapPolicy) {
+	request.SetIntProperty("OverlapPolicy", int32(value))
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyRequest.Clone()
+func (request *ScheduleTriggerRequest) Clone() IProxyMessage {
+	scheduleTriggerRequest := NewScheduleTriggerRequest()
+	var messageClone IProxyMessage = scheduleTriggerRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyRequest.CopyTo()
+func (request *ScheduleTriggerRequest) CopyTo(target IProxyMessage) {
+	request.ProxyRequest.CopyTo(target)
+	if v, ok := target.(*ScheduleTriggerRequest); ok {
+		v.SetScheduleID(request.GetScheduleID())
+		v.SetNamespace(request.GetNamespace())
+		v.SetOverlapPolicy(request.GetOverlapPolicy())
+	}
+}
@@ -0,0 +1,127 @@
+//-----------------------------------------------------------------------------
+// FILE:		schedule_unpause_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// ScheduleUnpauseRequest is a ProxyRequest of MessageType
+	// ScheduleUnpauseRequest.
+	//
+	// A ScheduleUnpauseRequest contains a reference to a
+	// ProxyRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this ProxyRequest
+	//
+	// Unpauses a previously paused Temporal schedule so it resumes
+	// taking actions.
+	ScheduleUnpauseRequest struct {
+		*ProxyRequest
+	}
+)
+
+// NewScheduleUnpauseRequest is the default constructor for a ScheduleUnpauseRequest
+//
+// returns *ScheduleUnpauseRequest -> a reference to a newly initialized
+// ScheduleUnpauseRequest in memory
+func NewScheduleUnpauseRequest() *ScheduleUnpauseRequest {
+	request := new(ScheduleUnpauseRequest)
+	request.ProxyRequest = NewProxyRequest()
+	request.SetType(internal.ScheduleUnpauseRequest)
+	request.SetReplyType(internal.ScheduleUnpauseReply)
+
+	return request
+}
+
+// GetScheduleID gets a ScheduleUnpauseRequest's ScheduleID value
+// from its properties map. Identifies the schedule to be unpaused.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleUnpauseRequest's ScheduleID
+func (request *ScheduleUnpauseRequest) GetScheduleID() *string {
+	return request.GetStringProperty("ScheduleId")
+}
+
+// SetScheduleID sets a ScheduleUnpauseRequest's ScheduleID value
+// in its properties map. Identifies the schedule to be unpaused.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleUnpauseRequest) SetScheduleID(value *string) {
+	request.SetStringProperty("ScheduleId", value)
+}
+
+// GetNamespace gets a ScheduleUnpauseRequest's Namespace value
+// from its properties map.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleUnpauseRequest's Namespace
+func (request *ScheduleUnpauseRequest) GetNamespace() *string {
+	return request.GetStringProperty("Namespace")
+}
+
+// SetNamespace sets a ScheduleUnpauseRequest's Namespace value
+// in its properties map.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleUnpauseRequest) SetNamespace(value *string) {
+	request.SetStringProperty("Namespace", value)
+}
+
+// GetNote gets a ScheduleUnpauseRequest's Note value from its properties
+// map. The note is recorded on the schedule to explain why it was unpaused.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleUnpauseRequest's Note
+func (request *ScheduleUnpauseRequest) GetNote() *string {
+	return request.GetStringProperty("Note")
+}
+
+// SetNote sets a ScheduleUnpauseRequest's Note value in its properties
+// map. The note is recorded on the schedule to explain why it was unpaused.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleUnpauseRequest) SetNote(value *string) {
+	request.SetStringProperty("Note", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyRequest.Clone()
+func (request *ScheduleUnpauseRequest) Clone() IProxyMessage {
+	scheduleUnpauseRequest := NewScheduleUnpauseRequest()
+	var messageClone IProxyMessage = scheduleUnpauseRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyRequest.CopyTo()
+func (request *ScheduleUnpauseRequest) CopyTo(target IProxyMessage) {
+	request.ProxyRequest.CopyTo(target)
+	if v, ok := target.(*ScheduleUnpauseRequest); ok {
+		v.SetScheduleID(request.GetScheduleID())
+		v.SetNamespace(request.GetNamespace())
+		v.SetNote(request.GetNote())
+	}
+}
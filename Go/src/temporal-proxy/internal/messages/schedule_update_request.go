@@ -0,0 +1,133 @@
+//-----------------------------------------------------------------------------
+// FILE:		schedule_update_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	"go.temporal.io/sdk/client"
+
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// ScheduleUpdateRequest is a ProxyRequest of MessageType
+	// ScheduleUpdateRequest.
+	//
+	// A ScheduleUpdateRequest contains a reference to a
+	// ProxyRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this ProxyRequest
+	//
+	// Updates the spec and/or action of an existing Temporal schedule.
+	ScheduleUpdateRequest struct {
+		*ProxyRequest
+	}
+)
+
+// NewScheduleUpdateRequest is the default constructor for a ScheduleUpdateRequest
+//
+// returns *ScheduleUpdateRequest -> a reference to a newly initialized
+// ScheduleUpdateRequest in memory
+func NewScheduleUpdateRequest() *ScheduleUpdateRequest {
+	request := new(ScheduleUpdateRequest)
+	request.ProxyRequest = NewProxyRequest()
+	request.SetType(internal.ScheduleUpdateRequest)
+	request.SetReplyType(internal.ScheduleUpdateReply)
+
+	return request
+}
+
+// GetScheduleID gets a ScheduleUpdateRequest's ScheduleID value
+// from its properties map. Identifies the schedule to be updated.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleUpdateRequest's ScheduleID
+func (request *ScheduleUpdateRequest) GetScheduleID() *string {
+	return request.GetStringProperty("ScheduleId")
+}
+
+// SetScheduleID sets a ScheduleUpdateRequest's ScheduleID value
+// in its properties map. Identifies the schedule to be updated.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleUpdateRequest) SetScheduleID(value *string) {
+	request.SetStringProperty("ScheduleId", value)
+}
+
+// GetNamespace gets a ScheduleUpdateRequest's Namespace value
+// from its properties map.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a ScheduleUpdateRequest's Namespace
+func (request *ScheduleUpdateRequest) GetNamespace() *string {
+	return request.GetStringProperty("Namespace")
+}
+
+// SetNamespace sets a ScheduleUpdateRequest's Namespace value
+// in its properties map.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *ScheduleUpdateRequest) SetNamespace(value *string) {
+	request.SetStringProperty("Namespace", value)
+}
+
+// GetSpec gets a ScheduleUpdateRequest's updated schedule spec
+// (calendar/interval/cron) from its properties map.
+//
+// returns *client.ScheduleSpec -> the updated schedule spec.
+func (request *ScheduleUpdateRequest) GetSpec() *client.ScheduleSpec {
+	spec := new(client.ScheduleSpec)
+	err := request.GetJSONProperty("Spec", spec)
+	if err != nil {
+		return nil
+	}
+
+	return spec
+}
+
+// SetSpec sets a ScheduleUpdateRequest's updated schedule spec
+// (calendar/interval/cron) in its properties map.
+//
+// param value *client.ScheduleSpec -> the updated schedule spec to
+// be set in the properties map.
+func (request *ScheduleUpdateRequest) SetSpec(value *client.ScheduleSpec) {
+	request.SetJSONProperty("Spec", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from ProxyRequest.Clone()
+func (request *ScheduleUpdateRequest) Clone() IProxyMessage {
+	scheduleUpdateRequest := NewScheduleUpdateRequest()
+	var messageClone IProxyMessage = scheduleUpdateRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from ProxyRequest.CopyTo()
+func (request *ScheduleUpdateRequest) CopyTo(target IProxyMessage) {
+	request.ProxyRequest.CopyTo(target)
+	if v, ok := target.(*ScheduleUpdateRequest); ok {
+		v.SetScheduleID(request.GetScheduleID())
+		v.SetNamespace(request.GetNamespace())
+		v.SetSpec(request.GetSpec())
+	}
+}
@@ -0,0 +1,110 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_batch_cancel_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowBatchCancelRequest is a WorkflowRequest of MessageType
+	// WorkflowBatchCancelRequest.
+	//
+	// A WorkflowBatchCancelRequest contains a reference to a
+	// WorkflowRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this WorkflowRequest
+	//
+	// Cancels every workflow matched by a visibility query via a
+	// Temporal batch operation.
+	WorkflowBatchCancelRequest struct {
+		*WorkflowRequest
+	}
+)
+
+// NewWorkflowBatchCancelRequest is the default constructor for a WorkflowBatchCancelRequest
+//
+// returns *WorkflowBatchCancelRequest -> a reference to a newly initialized
+// WorkflowBatchCancelRequest in memory
+func NewWorkflowBatchCancelRequest() *WorkflowBatchCancelRequest {
+	request := new(WorkflowBatchCancelRequest)
+	request.WorkflowRequest = NewWorkflowRequest()
+	request.SetType(internal.WorkflowBatchCancelRequest)
+	request.SetReplyType(internal.WorkflowBatchCancelReply)
+
+	return request
+}
+
+// GetQuery gets a WorkflowBatchCancelRequest's Query value from its
+// properties map. The SQL-like visibility query selecting the workflows
+// to cancel.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowBatchCancelRequest's Query
+func (request *WorkflowBatchCancelRequest) GetQuery() *string {
+	return request.GetStringProperty("Query")
+}
+
+// SetQuery sets a WorkflowBatchCancelRequest's Query value in its
+// properties map. The SQL-like visibility query selecting the workflows
+// to cancel.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowBatchCancelRequest) SetQuery(value *string) {
+	request.SetStringProperty("Query", value)
+}
+
+// GetReason gets a WorkflowBatchCancelRequest's Reason value from its
+// properties map. Explains why the batch of workflows is being cancelled.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowBatchCancelRequest's Reason
+func (request *WorkflowBatchCancelRequest) GetReason() *string {
+	return request.GetStringProperty("Reason")
+}
+
+// SetReason sets a WorkflowBatchCancelRequest's Reason value in its
+// properties map. Explains why the batch of workflows is being cancelled.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowBatchCancelRequest) SetReason(value *string) {
+	request.SetStringProperty("Reason", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowRequest.Clone()
+func (request *WorkflowBatchCancelRequest) Clone() IProxyMessage {
+	workflowBatchCancelRequest := NewWorkflowBatchCancelRequest()
+	var messageClone IProxyMessage = workflowBatchCancelRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowRequest.CopyTo()
+func (request *WorkflowBatchCancelRequest) CopyTo(target IProxyMessage) {
+	request.WorkflowRequest.CopyTo(target)
+	if v, ok := target.(*WorkflowBatchCancelRequest); ok {
+		v.SetQuery(request.GetQuery())
+		v.SetReason(request.GetReason())
+	}
+}
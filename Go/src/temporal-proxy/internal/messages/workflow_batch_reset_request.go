@@ -0,0 +1,133 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_batch_reset_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowBatchResetRequest is a WorkflowRequest of MessageType
+	// WorkflowBatchResetRequest.
+	//
+	// A WorkflowBatchResetRequest contains a reference to a
+	// WorkflowRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this WorkflowRequest
+	//
+	// Resets every workflow matched by a visibility query via a Temporal
+	// batch operation.
+	WorkflowBatchResetRequest struct {
+		*WorkflowRequest
+	}
+)
+
+// NewWorkflowBatchResetRequest is the default constructor for a WorkflowBatchResetRequest
+//
+// returns *WorkflowBatchResetRequest -> a reference to a newly initialized
+// WorkflowBatchResetRequest in memory
+func NewWorkflowBatchResetRequest() *WorkflowBatchResetRequest {
+	request := new(WorkflowBatchResetRequest)
+	request.WorkflowRequest = NewWorkflowRequest()
+	request.SetType(internal.WorkflowBatchResetRequest)
+	request.SetReplyType(internal.WorkflowBatchResetReply)
+
+	return request
+}
+
+// GetQuery gets a WorkflowBatchResetRequest's Query value from its
+// properties map. The SQL-like visibility query selecting the workflows
+// to reset.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowBatchResetRequest's Query
+func (request *WorkflowBatchResetRequest) GetQuery() *string {
+	return request.GetStringProperty("Query")
+}
+
+// SetQuery sets a WorkflowBatchResetRequest's Query value in its
+// properties map. The SQL-like visibility query selecting the workflows
+// to reset.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowBatchResetRequest) SetQuery(value *string) {
+	request.SetStringProperty("Query", value)
+}
+
+// GetReason gets a WorkflowBatchResetRequest's Reason value from its
+// properties map. Explains why the batch of workflows is being reset.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowBatchResetRequest's Reason
+func (request *WorkflowBatchResetRequest) GetReason() *string {
+	return request.GetStringProperty("Reason")
+}
+
+// SetReason sets a WorkflowBatchResetRequest's Reason value in its
+// properties map. Explains why the batch of workflows is being reset.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowBatchResetRequest) SetReason(value *string) {
+	request.SetStringProperty("Reason", value)
+}
+
+// GetResetType gets a WorkflowBatchResetRequest's ResetType value from
+// its properties map. The point in each matched workflow's history to
+// reset to (e.g. "FirstWorkflowTask", "LastWorkflowTask",
+// "LastContinuedAsNew").
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowBatchResetRequest's ResetType
+func (request *WorkflowBatchResetRequest) GetResetType() *string {
+	return request.GetStringProperty("ResetType")
+}
+
+// SetResetType sets a WorkflowBatchResetRequest's ResetType value in
+// its properties map. The point in each matched workflow's history to
+// reset to (e.g. "FirstWorkflowTask", "LastWorkflowTask",
+// "LastContinuedAsNew").
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowBatchResetRequest) SetResetType(value *string) {
+	request.SetStringProperty("ResetType", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowRequest.Clone()
+func (request *WorkflowBatchResetRequest) Clone() IProxyMessage {
+	workflowBatchResetRequest := NewWorkflowBatchResetRequest()
+	var messageClone IProxyMessage = workflowBatchResetRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowRequest.CopyTo()
+func (request *WorkflowBatchResetRequest) CopyTo(target IProxyMessage) {
+	request.WorkflowRequest.CopyTo(target)
+	if v, ok := target.(*WorkflowBatchResetRequest); ok {
+		v.SetQuery(request.GetQuery())
+		v.SetReason(request.GetReason())
+		v.SetResetType(request.GetResetType())
+	}
+}
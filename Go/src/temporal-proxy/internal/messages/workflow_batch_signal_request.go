@@ -0,0 +1,150 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_batch_signal_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowBatchSignalRequest is a WorkflowRequest of MessageType
+	// WorkflowBatchSignalRequest.
+	//
+	// A WorkflowBatchSignalRequest contains a reference to a
+	// WorkflowRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this WorkflowRequest
+	//
+	// Signals every workflow matched by a visibility query via a
+	// Temporal batch operation.
+	WorkflowBatchSignalRequest struct {
+		*WorkflowRequest
+	}
+)
+
+// NewWorkflowBatchSignalRequest is the default constructor for a WorkflowBatchSignalRequest
+//
+// returns *WorkflowBatchSignalRequest -> a reference to a newly initialized
+// WorkflowBatchSignalRequest in memory
+func NewWorkflowBatchSignalRequest() *WorkflowBatchSignalRequest {
+	request := new(WorkflowBatchSignalRequest)
+	request.WorkflowRequest = NewWorkflowRequest()
+	request.SetType(internal.WorkflowBatchSignalRequest)
+	request.SetReplyType(internal.WorkflowBatchSignalReply)
+
+	return request
+}
+
+// GetQuery gets a WorkflowBatchSignalRequest's Query value from its
+// properties map. The SQL-like visibility query selecting the workflows
+// to signal.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowBatchSignalRequest's Query
+func (request *WorkflowBatchSignalRequest) GetQuery() *string {
+	return request.GetStringProperty("Query")
+}
+
+// SetQuery sets a WorkflowBatchSignalRequest's Query value in its
+// properties map. The SQL-like visibility query selecting the workflows
+// to signal.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowBatchSignalRequest) SetQuery(value *string) {
+	request.SetStringProperty("Query", value)
+}
+
+// GetReason gets a WorkflowBatchSignalRequest's Reason value from its
+// properties map. Explains why the batch of workflows is being signalled.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowBatchSignalRequest's Reason
+func (request *WorkflowBatchSignalRequest) GetReason() *string {
+	return request.GetStringProperty("Reason")
+}
+
+// SetReason sets a WorkflowBatchSignalRequest's Reason value in its
+// properties map. Explains why the batch of workflows is being signalled.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowBatchSignalRequest) SetReason(value *string) {
+	request.SetStringProperty("Reason", value)
+}
+
+// GetSignalName gets a WorkflowBatchSignalRequest's SignalName value from
+// its properties map. The name of the signal channel to deliver to each
+// matched workflow.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowBatchSignalRequest's SignalName
+func (request *WorkflowBatchSignalRequest) GetSignalName() *string {
+	return request.GetStringProperty("SignalName")
+}
+
+// SetSignalName sets a WorkflowBatchSignalRequest's SignalName value in
+// its properties map. The name of the signal channel to deliver to each
+// matched workflow.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowBatchSignalRequest) SetSignalName(value *string) {
+	request.SetStringProperty("SignalName", value)
+}
+
+// GetSignalArgs gets a WorkflowBatchSignalRequest's SignalArgs field from
+// its properties map. SignalArgs is a []byte holding the arguments to
+// deliver with the signal.
+//
+// returns []byte -> []byte representing the signal arguments
+func (request *WorkflowBatchSignalRequest) GetSignalArgs() []byte {
+	return request.GetBytesProperty("SignalArgs")
+}
+
+// SetSignalArgs sets a WorkflowBatchSignalRequest's SignalArgs field in
+// its properties map. SignalArgs is a []byte holding the arguments to
+// deliver with the signal.
+//
+// param value []byte -> []byte representing the signal arguments
+func (request *WorkflowBatchSignalRequest) SetSignalArgs(value []byte) {
+	request.SetBytesProperty("SignalArgs", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowRequest.Clone()
+func (request *WorkflowBatchSignalRequest) Clone() IProxyMessage {
+	workflowBatchSignalRequest := NewWorkflowBatchSignalRequest()
+	var messageClone IProxyMessage = workflowBatchSignalRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowRequest.CopyTo()
+func (request *WorkflowBatchSignalRequest) CopyTo(target IProxyMessage) {
+	request.WorkflowRequest.CopyTo(target)
+	if v, ok := target.(*WorkflowBatchSignalRequest); ok {
+		v.SetQuery(request.GetQuery())
+		v.SetReason(request.GetReason())
+		v.SetSignalName(request.GetSignalName())
+		v.SetSignalArgs(request.GetSignalArgs())
+	}
+}
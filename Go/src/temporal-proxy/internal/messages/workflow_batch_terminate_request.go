@@ -0,0 +1,130 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_batch_terminate_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowBatchTerminateRequest is a WorkflowRequest of MessageType
+	// WorkflowBatchTerminateRequest.
+	//
+	// A WorkflowBatchTerminateRequest contains a reference to a
+	// WorkflowRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this WorkflowRequest
+	//
+	// Terminates every workflow matched by a visibility query via a
+	// Temporal batch operation, instead of terminating workflows one
+	// at a time.
+	WorkflowBatchTerminateRequest struct {
+		*WorkflowRequest
+	}
+)
+
+// NewWorkflowBatchTerminateRequest is the default constructor for a WorkflowBatchTerminateRequest
+//
+// returns *WorkflowBatchTerminateRequest -> a reference to a newly initialized
+// WorkflowBatchTerminateRequest in memory
+func NewWorkflowBatchTerminateRequest() *WorkflowBatchTerminateRequest {
+	request := new(WorkflowBatchTerminateRequest)
+	request.WorkflowRequest = NewWorkflowRequest()
+	request.SetType(internal.WorkflowBatchTerminateRequest)
+	request.SetReplyType(internal.WorkflowBatchTerminateReply)
+
+	return request
+}
+
+// GetQuery gets a WorkflowBatchTerminateRequest's Query value from its
+// properties map. The SQL-like visibility query selecting the workflows
+// to terminate (e.g. "WorkflowType='X' AND ExecutionStatus='Running'").
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowBatchTerminateRequest's Query
+func (request *WorkflowBatchTerminateRequest) GetQuery() *string {
+	return request.GetStringProperty("Query")
+}
+
+// SetQuery sets a WorkflowBatchTerminateRequest's Query value in its
+// properties map. The SQL-like visibility query selecting the workflows
+// to terminate.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowBatchTerminateRequest) SetQuery(value *string) {
+	request.SetStringProperty("Query", value)
+}
+
+// GetReason gets a WorkflowBatchTerminateRequest's Reason value from its
+// properties map. Explains why the batch of workflows is being terminated.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowBatchTerminateRequest's Reason
+func (request *WorkflowBatchTerminateRequest) GetReason() *string {
+	return request.GetStringProperty("Reason")
+}
+
+// SetReason sets a WorkflowBatchTerminateRequest's Reason value in its
+// properties map. Explains why the batch of workflows is being terminated.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowBatchTerminateRequest) SetReason(value *string) {
+	request.SetStringProperty("Reason", value)
+}
+
+// GetDetails gets a WorkflowBatchTerminateRequest's Details field from its
+// properties map. Details is a []byte holding the termination details to
+// record against each terminated workflow.
+//
+// returns []byte -> []byte representing the termination details
+func (request *WorkflowBatchTerminateRequest) GetDetails() []byte {
+	return request.GetBytesProperty("Details")
+}
+
+// SetDetails sets a WorkflowBatchTerminateRequest's Details field in its
+// properties map. Details is a []byte holding the termination details to
+// record against each terminated workflow.
+//
+// param value []byte -> []byte representing the termination details
+func (request *WorkflowBatchTerminateRequest) SetDetails(value []byte) {
+	request.SetBytesProperty("Details", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowRequest.Clone()
+func (request *WorkflowBatchTerminateRequest) Clone() IProxyMessage {
+	workflowBatchTerminateRequest := NewWorkflowBatchTerminateRequest()
+	var messageClone IProxyMessage = workflowBatchTerminateRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowRequest.CopyTo()
+func (request *WorkflowBatchTerminateRequest) CopyTo(target IProxyMessage) {
+	request.WorkflowRequest.CopyTo(target)
+	if v, ok := target.(*WorkflowBatchTerminateRequest); ok {
+		v.SetQuery(request.GetQuery())
+		v.SetReason(request.GetReason())
+		v.SetDetails(request.GetDetails())
+	}
+}
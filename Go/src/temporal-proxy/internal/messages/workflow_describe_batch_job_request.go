@@ -0,0 +1,91 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_describe_batch_job_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowDescribeBatchJobRequest is a WorkflowRequest of MessageType
+	// WorkflowDescribeBatchJobRequest.
+	//
+	// A WorkflowDescribeBatchJobRequest contains a reference to a
+	// WorkflowRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this WorkflowRequest
+	//
+	// Describes the progress of a previously started Temporal batch
+	// operation job.
+	WorkflowDescribeBatchJobRequest struct {
+		*WorkflowRequest
+	}
+)
+
+// NewWorkflowDescribeBatchJobRequest is the default constructor for a WorkflowDescribeBatchJobRequest
+//
+// returns *WorkflowDescribeBatchJobRequest -> a reference to a newly initialized
+// WorkflowDescribeBatchJobRequest in memory
+func NewWorkflowDescribeBatchJobRequest() *WorkflowDescribeBatchJobRequest {
+	request := new(WorkflowDescribeBatchJobRequest)
+	request.WorkflowRequest = NewWorkflowRequest()
+	request.SetType(internal.WorkflowDescribeBatchJobRequest)
+	request.SetReplyType(internal.WorkflowDescribeBatchJobReply)
+
+	return request
+}
+
+// GetJobID gets a WorkflowDescribeBatchJobRequest's JobID value from its
+// properties map. The id of the batch job to describe, as returned when
+// the proxy started the job.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowDescribeBatchJobRequest's JobID
+func (request *WorkflowDescribeBatchJobRequest) GetJobID() *string {
+	return request.GetStringProperty("JobId")
+}
+
+// SetJobID sets a WorkflowDescribeBatchJobRequest's JobID value in its
+// properties map. The id of the batch job to describe, as returned when
+// the proxy started the job.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowDescribeBatchJobRequest) SetJobID(value *string) {
+	request.SetStringProperty("JobId", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowRequest.Clone()
+func (request *WorkflowDescribeBatchJobRequest) Clone() IProxyMessage {
+	workflowDescribeBatchJobRequest := NewWorkflowDescribeBatchJobRequest()
+	var messageClone IProxyMessage = workflowDescribeBatchJobRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowRequest.CopyTo()
+func (request *WorkflowDescribeBatchJobRequest) CopyTo(target IProxyMessage) {
+	request.WorkflowRequest.CopyTo(target)
+	if v, ok := target.(*WorkflowDescribeBatchJobRequest); ok {
+		v.SetJobID(request.GetJobID())
+	}
+}
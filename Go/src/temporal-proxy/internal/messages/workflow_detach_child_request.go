@@ -0,0 +1,90 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_detach_child_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowDetachChildRequest is a WorkflowRequest of MessageType
+	// WorkflowDetachChildRequest.
+	//
+	// A WorkflowDetachChildRequest contains a reference to a
+	// WorkflowRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this WorkflowRequest
+	//
+	// Forgets the child workflow identified by ChildID running under the
+	// parent workflow context at ContextID, without requesting its
+	// cancellation.  The child continues running independently of its
+	// parent and the proxy stops tracking it.
+	WorkflowDetachChildRequest struct {
+		*WorkflowRequest
+	}
+)
+
+// NewWorkflowDetachChildRequest is the default constructor for a
+// WorkflowDetachChildRequest
+//
+// returns *WorkflowDetachChildRequest -> a reference to a newly
+// initialized WorkflowDetachChildRequest in memory
+func NewWorkflowDetachChildRequest() *WorkflowDetachChildRequest {
+	request := new(WorkflowDetachChildRequest)
+	request.WorkflowRequest = NewWorkflowRequest()
+	request.SetType(internal.WorkflowDetachChildRequest)
+	request.SetReplyType(internal.WorkflowDetachChildReply)
+
+	return request
+}
+
+// GetChildID gets a WorkflowDetachChildRequest's ChildID value from its
+// properties map. Identifies the child workflow context to detach.
+//
+// returns int64 -> the ChildID of the child workflow to detach
+func (request *WorkflowDetachChildRequest) GetChildID() int64 {
+	return int64(request.GetIntProperty("ChildId"))
+}
+
+// SetChildID sets a WorkflowDetachChildRequest's ChildID value in its
+// properties map. Identifies the child workflow context to detach.
+//
+// param value int64 -> the ChildID of the child workflow to detach
+func (request *WorkflowDetachChildRequest) SetChildID(value int64) {
+	request.SetIntProperty("ChildId", int(value))
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowRequest.Clone()
+func (request *WorkflowDetachChildRequest) Clone() IProxyMessage {
+	workflowDetachChildRequest := NewWorkflowDetachChildRequest()
+	var messageClone IProxyMessage = workflowDetachChildRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowRequest.CopyTo()
+func (request *WorkflowDetachChildRequest) CopyTo(target IProxyMessage) {
+	request.WorkflowRequest.CopyTo(target)
+	if v, ok := target.(*WorkflowDetachChildRequest); ok {
+		v.SetChildID(request.GetChildID())
+	}
+}
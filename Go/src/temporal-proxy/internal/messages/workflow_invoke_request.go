@@ -225,6 +225,123 @@ func (request *WorkflowInvokeRequest) SetReplayStatus(value internal.ReplayStatu
 	request.SetStringProperty("ReplayStatus", &status)
 }
 
+// GetReplayAware gets the ReplayAware flag from a WorkflowInvokeRequest's
+// properties map. When true, the proxy computes and sets ReplayStatus on
+// the WorkflowInvokeReply it settles for this request; when false, the
+// proxy skips that computation to avoid paying its cost for clients that
+// don't use it.
+//
+// returns bool -> true if the client wants ReplayStatus populated.
+func (request *WorkflowInvokeRequest) GetReplayAware() bool {
+	replayAwarePtr := request.GetStringProperty("ReplayAware")
+
+	return replayAwarePtr != nil && *replayAwarePtr == "true"
+}
+
+// SetReplayAware sets the ReplayAware flag in a WorkflowInvokeRequest's
+// properties map. When true, the proxy computes and sets ReplayStatus on
+// the WorkflowInvokeReply it settles for this request; when false, the
+// proxy skips that computation to avoid paying its cost for clients that
+// don't use it.
+//
+// param value bool -> true if the client wants ReplayStatus populated.
+func (request *WorkflowInvokeRequest) SetReplayAware(value bool) {
+	status := "false"
+	if value {
+		status = "true"
+	}
+	request.SetStringProperty("ReplayAware", &status)
+}
+
+// GetWorkflowTaskType gets the WorkflowTaskType from a
+// WorkflowInvokeRequest's properties map, distinguishing a Normal
+// workflow task from a Speculative one the server may discard from
+// history if the workflow makes no progress handling it (e.g. a
+// query-only or update-validation task). See the CONTRACT on
+// internal.WorkflowTaskType.
+//
+// returns internal.WorkflowTaskType -> the type of workflow task this
+// request is invoking.
+func (request *WorkflowInvokeRequest) GetWorkflowTaskType() internal.WorkflowTaskType {
+	taskTypePtr := request.GetStringProperty("WorkflowTaskType")
+	if taskTypePtr == nil {
+		return internal.WorkflowTaskTypeUnspecified
+	}
+
+	return internal.StringToWorkflowTaskType(*taskTypePtr)
+}
+
+// SetWorkflowTaskType sets the WorkflowTaskType in a
+// WorkflowInvokeRequest's properties map, distinguishing a Normal
+// workflow task from a Speculative one the server may discard from
+// history if the workflow makes no progress handling it (e.g. a
+// query-only or update-validation task). See the CONTRACT on
+// internal.WorkflowTaskType.
+//
+// param value internal.WorkflowTaskType -> the type of workflow task
+// this request is invoking.
+func (request *WorkflowInvokeRequest) SetWorkflowTaskType(value internal.WorkflowTaskType) {
+	taskType := value.String()
+	request.SetStringProperty("WorkflowTaskType", &taskType)
+}
+
+// GetEagerlyDispatched gets the EagerlyDispatched flag from a
+// WorkflowInvokeRequest's properties map. When true, this invocation's
+// first workflow task was returned inline by the Temporal server's
+// StartWorkflowExecution response rather than picked up by a
+// task-queue poll, letting the Neon.Temporal client log/metric it.
+//
+// NOTE: the Go SDK doesn't expose whether a given task arrived via
+// eager dispatch to the registered workflow function that constructs
+// this request, so nothing in this proxy build sets this true yet --
+// it's always its zero value until that signal exists.
+//
+// returns bool -> true if this invocation was eagerly dispatched.
+func (request *WorkflowInvokeRequest) GetEagerlyDispatched() bool {
+	eagerlyDispatchedPtr := request.GetStringProperty("EagerlyDispatched")
+
+	return eagerlyDispatchedPtr != nil && *eagerlyDispatchedPtr == "true"
+}
+
+// SetEagerlyDispatched sets the EagerlyDispatched flag in a
+// WorkflowInvokeRequest's properties map. See GetEagerlyDispatched.
+//
+// param value bool -> true if this invocation was eagerly dispatched.
+func (request *WorkflowInvokeRequest) SetEagerlyDispatched(value bool) {
+	status := "false"
+	if value {
+		status = "true"
+	}
+	request.SetStringProperty("EagerlyDispatched", &status)
+}
+
+// GetHeaders gets a WorkflowInvokeRequest's Headers field from its
+// properties map. Headers carries the inbound gRPC headers (auth tokens,
+// tenant ids, tracing baggage) that were attached to the workflow so the
+// Neon.Temporal client can thread them through its own interceptors.
+//
+// returns map[string][]byte -> the inbound gRPC headers.
+func (request *WorkflowInvokeRequest) GetHeaders() map[string][]byte {
+	headers := make(map[string][]byte)
+	err := request.GetJSONProperty("Headers", &headers)
+	if err != nil {
+		return nil
+	}
+
+	return headers
+}
+
+// SetHeaders sets a WorkflowInvokeRequest's Headers field in its
+// properties map. Headers carries the inbound gRPC headers (auth tokens,
+// tenant ids, tracing baggage) that were attached to the workflow so the
+// Neon.Temporal client can thread them through its own interceptors.
+//
+// param value map[string][]byte -> the inbound gRPC headers to be set
+// in the properties map.
+func (request *WorkflowInvokeRequest) SetHeaders(value map[string][]byte) {
+	request.SetJSONProperty("Headers", value)
+}
+
 // -------------------------------------------------------------------------
 // IProxyMessage interface methods for implementing the IProxyMessage interface
 
@@ -250,5 +367,9 @@ func (request *WorkflowInvokeRequest) CopyTo(target IProxyMessage) {
 		v.SetTaskQueue(request.GetTaskQueue())
 		v.SetExecutionStartToCloseTimeout(request.GetExecutionStartToCloseTimeout())
 		v.SetReplayStatus(request.GetReplayStatus())
+		v.SetReplayAware(request.GetReplayAware())
+		v.SetWorkflowTaskType(request.GetWorkflowTaskType())
+		v.SetEagerlyDispatched(request.GetEagerlyDispatched())
+		v.SetHeaders(request.GetHeaders())
 	}
 }
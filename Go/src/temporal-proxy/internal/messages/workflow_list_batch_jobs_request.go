@@ -0,0 +1,88 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_list_batch_jobs_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowListBatchJobsRequest is a WorkflowRequest of MessageType
+	// WorkflowListBatchJobsRequest.
+	//
+	// A WorkflowListBatchJobsRequest contains a reference to a
+	// WorkflowRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this WorkflowRequest
+	//
+	// Lists the Temporal batch operation jobs started against a namespace.
+	WorkflowListBatchJobsRequest struct {
+		*WorkflowRequest
+	}
+)
+
+// NewWorkflowListBatchJobsRequest is the default constructor for a WorkflowListBatchJobsRequest
+//
+// returns *WorkflowListBatchJobsRequest -> a reference to a newly initialized
+// WorkflowListBatchJobsRequest in memory
+func NewWorkflowListBatchJobsRequest() *WorkflowListBatchJobsRequest {
+	request := new(WorkflowListBatchJobsRequest)
+	request.WorkflowRequest = NewWorkflowRequest()
+	request.SetType(internal.WorkflowListBatchJobsRequest)
+	request.SetReplyType(internal.WorkflowListBatchJobsReply)
+
+	return request
+}
+
+// GetNamespace gets a WorkflowListBatchJobsRequest's Namespace value from
+// its properties map. The namespace whose batch jobs are being listed.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowListBatchJobsRequest's Namespace
+func (request *WorkflowListBatchJobsRequest) GetNamespace() *string {
+	return request.GetStringProperty("Namespace")
+}
+
+// SetNamespace sets a WorkflowListBatchJobsRequest's Namespace value in
+// its properties map. The namespace whose batch jobs are being listed.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowListBatchJobsRequest) SetNamespace(value *string) {
+	request.SetStringProperty("Namespace", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowRequest.Clone()
+func (request *WorkflowListBatchJobsRequest) Clone() IProxyMessage {
+	workflowListBatchJobsRequest := NewWorkflowListBatchJobsRequest()
+	var messageClone IProxyMessage = workflowListBatchJobsRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowRequest.CopyTo()
+func (request *WorkflowListBatchJobsRequest) CopyTo(target IProxyMessage) {
+	request.WorkflowRequest.CopyTo(target)
+	if v, ok := target.(*WorkflowListBatchJobsRequest); ok {
+		v.SetNamespace(request.GetNamespace())
+	}
+}
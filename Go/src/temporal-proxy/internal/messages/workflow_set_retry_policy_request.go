@@ -0,0 +1,97 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_set_retry_policy_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	proxyclient "temporal-proxy/internal/temporal/client"
+
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowSetRetryPolicyRequest is a WorkflowRequest of MessageType
+	// WorkflowSetRetryPolicyRequest.
+	//
+	// A WorkflowSetRetryPolicyRequest contains a reference to a
+	// WorkflowRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this WorkflowRequest
+	//
+	// Overrides the RetryPolicy that the RetryableClientHelper registered
+	// for the requesting client uses to retry transient temporal client
+	// errors.
+	WorkflowSetRetryPolicyRequest struct {
+		*WorkflowRequest
+	}
+)
+
+// NewWorkflowSetRetryPolicyRequest is the default constructor for a
+// WorkflowSetRetryPolicyRequest
+//
+// returns *WorkflowSetRetryPolicyRequest -> a reference to a newly
+// initialized WorkflowSetRetryPolicyRequest in memory
+func NewWorkflowSetRetryPolicyRequest() *WorkflowSetRetryPolicyRequest {
+	request := new(WorkflowSetRetryPolicyRequest)
+	request.WorkflowRequest = NewWorkflowRequest()
+	request.SetType(internal.WorkflowSetRetryPolicyRequest)
+	request.SetReplyType(internal.WorkflowSetRetryPolicyReply)
+
+	return request
+}
+
+// GetRetryPolicy gets a WorkflowSetRetryPolicyRequest's RetryPolicy value
+// from its properties map. The policy to apply to the requesting client's
+// RetryableClientHelper.
+//
+// returns proxyclient.RetryPolicy -> the retry policy to apply.
+func (request *WorkflowSetRetryPolicyRequest) GetRetryPolicy() proxyclient.RetryPolicy {
+	var policy proxyclient.RetryPolicy
+	request.GetJSONProperty("RetryPolicy", &policy)
+
+	return policy
+}
+
+// SetRetryPolicy sets a WorkflowSetRetryPolicyRequest's RetryPolicy value
+// in its properties map. The policy to apply to the requesting client's
+// RetryableClientHelper.
+//
+// param value proxyclient.RetryPolicy -> the retry policy to be set in the
+// properties map.
+func (request *WorkflowSetRetryPolicyRequest) SetRetryPolicy(value proxyclient.RetryPolicy) {
+	request.SetJSONProperty("RetryPolicy", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowRequest.Clone()
+func (request *WorkflowSetRetryPolicyRequest) Clone() IProxyMessage {
+	workflowSetRetryPolicyRequest := NewWorkflowSetRetryPolicyRequest()
+	var messageClone IProxyMessage = workflowSetRetryPolicyRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowRequest.CopyTo()
+func (request *WorkflowSetRetryPolicyRequest) CopyTo(target IProxyMessage) {
+	request.WorkflowRequest.CopyTo(target)
+	if v, ok := target.(*WorkflowSetRetryPolicyRequest); ok {
+		v.SetRetryPolicy(request.GetRetryPolicy())
+	}
+}
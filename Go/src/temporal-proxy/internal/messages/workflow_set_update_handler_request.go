@@ -0,0 +1,93 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_set_update_handler_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowSetUpdateHandlerRequest is a WorkflowRequest of MessageType
+	// WorkflowSetUpdateHandlerRequest.
+	//
+	// A WorkflowSetUpdateHandlerRequest contains a reference to a
+	// WorkflowRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this WorkflowRequest
+	//
+	// Registers a named Temporal Update handler (and validator) for the
+	// workflow executing at ContextID, mirroring
+	// WorkflowSetQueryHandlerRequest for Temporal's Update feature.
+	WorkflowSetUpdateHandlerRequest struct {
+		*WorkflowRequest
+	}
+)
+
+// NewWorkflowSetUpdateHandlerRequest is the default constructor for a
+// WorkflowSetUpdateHandlerRequest
+//
+// returns *WorkflowSetUpdateHandlerRequest -> a reference to a newly
+// initialized WorkflowSetUpdateHandlerRequest in memory
+func NewWorkflowSetUpdateHandlerRequest() *WorkflowSetUpdateHandlerRequest {
+	request := new(WorkflowSetUpdateHandlerRequest)
+	request.WorkflowRequest = NewWorkflowRequest()
+	request.SetType(internal.WorkflowSetUpdateHandlerRequest)
+	request.SetReplyType(internal.WorkflowSetUpdateHandlerReply)
+
+	return request
+}
+
+// GetUpdateName gets a WorkflowSetUpdateHandlerRequest's UpdateName
+// value from its properties map. The name of the update to register a
+// handler for.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowSetUpdateHandlerRequest's UpdateName
+func (request *WorkflowSetUpdateHandlerRequest) GetUpdateName() *string {
+	return request.GetStringProperty("UpdateName")
+}
+
+// SetUpdateName sets a WorkflowSetUpdateHandlerRequest's UpdateName
+// value in its properties map. The name of the update to register a
+// handler for.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowSetUpdateHandlerRequest) SetUpdateName(value *string) {
+	request.SetStringProperty("UpdateName", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowRequest.Clone()
+func (request *WorkflowSetUpdateHandlerRequest) Clone() IProxyMessage {
+	workflowSetUpdateHandlerRequest := NewWorkflowSetUpdateHandlerRequest()
+	var messageClone IProxyMessage = workflowSetUpdateHandlerRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowRequest.CopyTo()
+func (request *WorkflowSetUpdateHandlerRequest) CopyTo(target IProxyMessage) {
+	request.WorkflowRequest.CopyTo(target)
+	if v, ok := target.(*WorkflowSetUpdateHandlerRequest); ok {
+		v.SetUpdateName(request.GetUpdateName())
+	}
+}
@@ -0,0 +1,109 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_stop_batch_job_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowStopBatchJobRequest is a WorkflowRequest of MessageType
+	// WorkflowStopBatchJobRequest.
+	//
+	// A WorkflowStopBatchJobRequest contains a reference to a
+	// WorkflowRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this WorkflowRequest
+	//
+	// Stops a previously started Temporal batch operation job.
+	WorkflowStopBatchJobRequest struct {
+		*WorkflowRequest
+	}
+)
+
+// NewWorkflowStopBatchJobRequest is the default constructor for a WorkflowStopBatchJobRequest
+//
+// returns *WorkflowStopBatchJobRequest -> a reference to a newly initialized
+// WorkflowStopBatchJobRequest in memory
+func NewWorkflowStopBatchJobRequest() *WorkflowStopBatchJobRequest {
+	request := new(WorkflowStopBatchJobRequest)
+	request.WorkflowRequest = NewWorkflowRequest()
+	request.SetType(internal.WorkflowStopBatchJobRequest)
+	request.SetReplyType(internal.WorkflowStopBatchJobReply)
+
+	return request
+}
+
+// GetJobID gets a WorkflowStopBatchJobRequest's JobID value from its
+// properties map. The id of the batch job to stop, as returned when the
+// proxy started the job.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowStopBatchJobRequest's JobID
+func (request *WorkflowStopBatchJobRequest) GetJobID() *string {
+	return request.GetStringProperty("JobId")
+}
+
+// SetJobID sets a WorkflowStopBatchJobRequest's JobID value in its
+// properties map. The id of the batch job to stop, as returned when the
+// proxy started the job.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowStopBatchJobRequest) SetJobID(value *string) {
+	request.SetStringProperty("JobId", value)
+}
+
+// GetReason gets a WorkflowStopBatchJobRequest's Reason value from its
+// properties map. Explains why the batch job is being stopped.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowStopBatchJobRequest's Reason
+func (request *WorkflowStopBatchJobRequest) GetReason() *string {
+	return request.GetStringProperty("Reason")
+}
+
+// SetReason sets a WorkflowStopBatchJobRequest's Reason value in its
+// properties map. Explains why the batch job is being stopped.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowStopBatchJobRequest) SetReason(value *string) {
+	request.SetStringProperty("Reason", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowRequest.Clone()
+func (request *WorkflowStopBatchJobRequest) Clone() IProxyMessage {
+	workflowStopBatchJobRequest := NewWorkflowStopBatchJobRequest()
+	var messageClone IProxyMessage = workflowStopBatchJobRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowRequest.CopyTo()
+func (request *WorkflowStopBatchJobRequest) CopyTo(target IProxyMessage) {
+	request.WorkflowRequest.CopyTo(target)
+	if v, ok := target.(*WorkflowStopBatchJobRequest); ok {
+		v.SetJobID(request.GetJobID())
+		v.SetReason(request.GetReason())
+	}
+}
@@ -0,0 +1,87 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_update_invoke_reply.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowUpdateInvokeReply is a WorkflowReply of MessageType
+	// WorkflowUpdateInvokeReply.  It holds a reference to a WorkflowReply
+	// in memory and is the reply type to a WorkflowUpdateInvokeRequest.
+	//
+	// The Neon.Temporal client sets Result on an accepted-and-completed
+	// update, or Error when the update handler or validator rejected the
+	// update.
+	WorkflowUpdateInvokeReply struct {
+		*WorkflowReply
+	}
+)
+
+// NewWorkflowUpdateInvokeReply is the default constructor for a
+// WorkflowUpdateInvokeReply
+//
+// returns *WorkflowUpdateInvokeReply -> a pointer to a newly initialized
+// WorkflowUpdateInvokeReply in memory
+func NewWorkflowUpdateInvokeReply() *WorkflowUpdateInvokeReply {
+	reply := new(WorkflowUpdateInvokeReply)
+	reply.WorkflowReply = NewWorkflowReply()
+	reply.SetType(internal.WorkflowUpdateInvokeReply)
+
+	return reply
+}
+
+// GetResult gets a WorkflowUpdateInvokeReply's Result field from its
+// properties map. Result is the []byte encoded return value of the
+// update handler, set when the update was accepted and completed.
+//
+// returns []byte -> the update handler's result encoded as bytes.
+func (reply *WorkflowUpdateInvokeReply) GetResult() []byte {
+	return reply.GetBytesProperty("Result")
+}
+
+// SetResult sets a WorkflowUpdateInvokeReply's Result field in its
+// properties map. Result is the []byte encoded return value of the
+// update handler, set when the update was accepted and completed.
+//
+// param value []byte -> the update handler's result encoded as bytes.
+func (reply *WorkflowUpdateInvokeReply) SetResult(value []byte) {
+	reply.SetBytesProperty("Result", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowReply.Clone()
+func (reply *WorkflowUpdateInvokeReply) Clone() IProxyMessage {
+	workflowUpdateInvokeReply := NewWorkflowUpdateInvokeReply()
+	var messageClone IProxyMessage = workflowUpdateInvokeReply
+	reply.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowReply.CopyTo()
+func (reply *WorkflowUpdateInvokeReply) CopyTo(target IProxyMessage) {
+	reply.WorkflowReply.CopyTo(target)
+	if v, ok := target.(*WorkflowUpdateInvokeReply); ok {
+		v.SetResult(reply.GetResult())
+	}
+}
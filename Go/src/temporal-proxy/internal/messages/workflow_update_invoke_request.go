@@ -0,0 +1,132 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_update_invoke_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowUpdateInvokeRequest is a WorkflowRequest of MessageType
+	// WorkflowUpdateInvokeRequest.
+	//
+	// A WorkflowUpdateInvokeRequest contains a reference to a
+	// WorkflowRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this WorkflowRequest
+	//
+	// A WorkflowUpdateInvokeRequest is sent to the Neon.Temporal client
+	// to invoke a registered update handler, passing along the update's
+	// arguments, once the update has passed validation (see
+	// WorkflowUpdateValidateInvokeRequest).
+	WorkflowUpdateInvokeRequest struct {
+		*WorkflowRequest
+	}
+)
+
+// NewWorkflowUpdateInvokeRequest is the default constructor for a
+// WorkflowUpdateInvokeRequest
+//
+// returns *WorkflowUpdateInvokeRequest -> a reference to a newly
+// initialized WorkflowUpdateInvokeRequest in memory
+func NewWorkflowUpdateInvokeRequest() *WorkflowUpdateInvokeRequest {
+	request := new(WorkflowUpdateInvokeRequest)
+	request.WorkflowRequest = NewWorkflowRequest()
+	request.SetType(internal.WorkflowUpdateInvokeRequest)
+	request.SetReplyType(internal.WorkflowUpdateInvokeReply)
+
+	return request
+}
+
+// GetUpdateName gets a WorkflowUpdateInvokeRequest's UpdateName value
+// from its properties map. The name of the update handler being invoked.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowUpdateInvokeRequest's UpdateName
+func (request *WorkflowUpdateInvokeRequest) GetUpdateName() *string {
+	return request.GetStringProperty("UpdateName")
+}
+
+// SetUpdateName sets a WorkflowUpdateInvokeRequest's UpdateName value
+// in its properties map. The name of the update handler being invoked.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowUpdateInvokeRequest) SetUpdateName(value *string) {
+	request.SetStringProperty("UpdateName", value)
+}
+
+// GetUpdateID gets a WorkflowUpdateInvokeRequest's UpdateID value from
+// its properties map. The id Temporal assigned to the update being
+// invoked.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowUpdateInvokeRequest's UpdateID
+func (request *WorkflowUpdateInvokeRequest) GetUpdateID() *string {
+	return request.GetStringProperty("UpdateId")
+}
+
+// SetUpdateID sets a WorkflowUpdateInvokeRequest's UpdateID value in
+// its properties map. The id Temporal assigned to the update being
+// invoked.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowUpdateInvokeRequest) SetUpdateID(value *string) {
+	request.SetStringProperty("UpdateId", value)
+}
+
+// GetArgs gets a WorkflowUpdateInvokeRequest's Args field from its
+// properties map. Args is a []byte holding the arguments passed to
+// the update handler.
+//
+// returns []byte -> a []byte representing the update arguments.
+func (request *WorkflowUpdateInvokeRequest) GetArgs() []byte {
+	return request.GetBytesProperty("Args")
+}
+
+// SetArgs sets a WorkflowUpdateInvokeRequest's Args field in its
+// properties map. Args is a []byte holding the arguments passed to
+// the update handler.
+//
+// param value []byte -> a []byte representing the update arguments.
+func (request *WorkflowUpdateInvokeRequest) SetArgs(value []byte) {
+	request.SetBytesProperty("Args", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowRequest.Clone()
+func (request *WorkflowUpdateInvokeRequest) Clone() IProxyMessage {
+	workflowUpdateInvokeRequest := NewWorkflowUpdateInvokeRequest()
+	var messageClone IProxyMessage = workflowUpdateInvokeRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowRequest.CopyTo()
+func (request *WorkflowUpdateInvokeRequest) CopyTo(target IProxyMessage) {
+	request.WorkflowRequest.CopyTo(target)
+	if v, ok := target.(*WorkflowUpdateInvokeRequest); ok {
+		v.SetUpdateName(request.GetUpdateName())
+		v.SetUpdateID(request.GetUpdateID())
+		v.SetArgs(request.GetArgs())
+	}
+}
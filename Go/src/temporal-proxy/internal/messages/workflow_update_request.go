@@ -0,0 +1,207 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_update_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	"go.temporal.io/sdk/client"
+
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowUpdateRequest is a WorkflowRequest of MessageType
+	// WorkflowUpdateRequest.
+	//
+	// A WorkflowUpdateRequest contains a reference to a
+	// WorkflowRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this WorkflowRequest
+	//
+	// Sends a Temporal Update to a running workflow execution and waits
+	// for the update to reach the requested lifecycle stage.
+	WorkflowUpdateRequest struct {
+		*WorkflowRequest
+	}
+)
+
+// NewWorkflowUpdateRequest is the default constructor for a WorkflowUpdateRequest
+//
+// returns *WorkflowUpdateRequest -> a reference to a newly initialized
+// WorkflowUpdateRequest in memory
+func NewWorkflowUpdateRequest() *WorkflowUpdateRequest {
+	request := new(WorkflowUpdateRequest)
+	request.WorkflowRequest = NewWorkflowRequest()
+	request.SetType(internal.WorkflowUpdateRequest)
+	request.SetReplyType(internal.WorkflowUpdateReply)
+
+	return request
+}
+
+// GetWorkflowID gets a WorkflowUpdateRequest's WorkflowID value
+// from its properties map. The workflowID of the workflow to update.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowUpdateRequest's WorkflowID
+func (request *WorkflowUpdateRequest) GetWorkflowID() *string {
+	return request.GetStringProperty("WorkflowId")
+}
+
+// SetWorkflowID sets a WorkflowUpdateRequest's WorkflowID value
+// in its properties map. The workflowID of the workflow to update.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowUpdateRequest) SetWorkflowID(value *string) {
+	request.SetStringProperty("WorkflowId", value)
+}
+
+// GetRunID gets a WorkflowUpdateRequest's RunID value
+// from its properties map. The runID of the workflow to update.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowUpdateRequest's RunID
+func (request *WorkflowUpdateRequest) GetRunID() *string {
+	return request.GetStringProperty("RunId")
+}
+
+// SetRunID sets a WorkflowUpdateRequest's RunID value
+// in its properties map. The runID of the workflow to update.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowUpdateRequest) SetRunID(value *string) {
+	request.SetStringProperty("RunId", value)
+}
+
+// GetNamespace gets a WorkflowUpdateRequest's Namespace value
+// from its properties map. The namespace the workflow is executing on.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowUpdateRequest's Namespace
+func (request *WorkflowUpdateRequest) GetNamespace() *string {
+	return request.GetStringProperty("Namespace")
+}
+
+// SetNamespace sets a WorkflowUpdateRequest's Namespace value
+// in its properties map. The namespace the workflow is executing on.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowUpdateRequest) SetNamespace(value *string) {
+	request.SetStringProperty("Namespace", value)
+}
+
+// GetUpdateName gets a WorkflowUpdateRequest's UpdateName value
+// from its properties map. The name of the update handler to invoke.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowUpdateRequest's UpdateName
+func (request *WorkflowUpdateRequest) GetUpdateName() *string {
+	return request.GetStringProperty("UpdateName")
+}
+
+// SetUpdateName sets a WorkflowUpdateRequest's UpdateName value
+// in its properties map. The name of the update handler to invoke.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowUpdateRequest) SetUpdateName(value *string) {
+	request.SetStringProperty("UpdateName", value)
+}
+
+// GetUpdateID gets a WorkflowUpdateRequest's UpdateID value
+// from its properties map. The caller supplied id used to deduplicate
+// the update; a new id is assigned by Temporal when nil.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowUpdateRequest's UpdateID
+func (request *WorkflowUpdateRequest) GetUpdateID() *string {
+	return request.GetStringProperty("UpdateId")
+}
+
+// SetUpdateID sets a WorkflowUpdateRequest's UpdateID value
+// in its properties map. The caller supplied id used to deduplicate
+// the update; a new id is assigned by Temporal when nil.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowUpdateRequest) SetUpdateID(value *string) {
+	request.SetStringProperty("UpdateId", value)
+}
+
+// GetArgs gets a WorkflowUpdateRequest's Args field from its properties
+// map. Args is a []byte holding the arguments for the update handler.
+//
+// returns []byte -> a []byte representing the update arguments.
+func (request *WorkflowUpdateRequest) GetArgs() []byte {
+	return request.GetBytesProperty("Args")
+}
+
+// SetArgs sets a WorkflowUpdateRequest's Args field in its properties
+// map. Args is a []byte holding the arguments for the update handler.
+//
+// param value []byte -> a []byte representing the update arguments.
+func (request *WorkflowUpdateRequest) SetArgs(value []byte) {
+	request.SetBytesProperty("Args", value)
+}
+
+// GetWaitForStage gets a WorkflowUpdateRequest's WaitForStage value from
+// its properties map. The lifecycle stage (Admitted, Accepted, or
+// Completed) the caller wants the update to reach before this request
+// returns.
+//
+// returns client.WorkflowUpdateStage -> the lifecycle stage to wait for.
+func (request *WorkflowUpdateRequest) GetWaitForStage() client.WorkflowUpdateStage {
+	return client.WorkflowUpdateStage(request.GetIntProperty("WaitForStage"))
+}
+
+// SetWaitForStage sets a WorkflowUpdateRequest's WaitForStage value in
+// its properties map. The lifecycle stage (Admitted, Accepted, or
+// Completed) the caller wants the update to reach before this request
+// returns.
+//
+// param value client.WorkflowUpdateStage -> the lifecycle stage to wait for.
+func (request *WorkflowUpdateRequest) SetWaitForStage(value client.WorkflowUpdateStage) {
+	request.SetIntProperty("WaitForStage", int32(value))
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowRequest.Clone()
+func (request *WorkflowUpdateRequest) Clone() IProxyMessage {
+	workflowUpdateRequest := NewWorkflowUpdateRequest()
+	var messageClone IProxyMessage = workflowUpdateRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowRequest.CopyTo()
+func (request *WorkflowUpdateRequest) CopyTo(target IProxyMessage) {
+	request.WorkflowRequest.CopyTo(target)
+	if v, ok := target.(*WorkflowUpdateRequest); ok {
+		v.SetWorkflowID(request.GetWorkflowID())
+		v.SetRunID(request.GetRunID())
+		v.SetNamespace(request.GetNamespace())
+		v.SetUpdateName(request.GetUpdateName())
+		v.SetUpdateID(request.GetUpdateID())
+		v.SetArgs(request.GetArgs())
+		v.SetWaitForStage(request.GetWaitForStage())
+	}
+}
@@ -0,0 +1,66 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_update_validate_invoke_reply.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowUpdateValidateInvokeReply is a WorkflowReply of MessageType
+	// WorkflowUpdateValidateInvokeReply.  It holds a reference to a
+	// WorkflowReply in memory and is the reply type to a
+	// WorkflowUpdateValidateInvokeRequest.
+	//
+	// The Neon.Temporal client leaves Error unset to accept the update,
+	// or sets it to the validator's rejection error to reject it.
+	WorkflowUpdateValidateInvokeReply struct {
+		*WorkflowReply
+	}
+)
+
+// NewWorkflowUpdateValidateInvokeReply is the default constructor for a
+// WorkflowUpdateValidateInvokeReply
+//
+// returns *WorkflowUpdateValidateInvokeReply -> a pointer to a newly
+// initialized WorkflowUpdateValidateInvokeReply in memory
+func NewWorkflowUpdateValidateInvokeReply() *WorkflowUpdateValidateInvokeReply {
+	reply := new(WorkflowUpdateValidateInvokeReply)
+	reply.WorkflowReply = NewWorkflowReply()
+	reply.SetType(internal.WorkflowUpdateValidateInvokeReply)
+
+	return reply
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowReply.Clone()
+func (reply *WorkflowUpdateValidateInvokeReply) Clone() IProxyMessage {
+	workflowUpdateValidateInvokeReply := NewWorkflowUpdateValidateInvokeReply()
+	var messageClone IProxyMessage = workflowUpdateValidateInvokeReply
+	reply.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowReply.CopyTo()
+func (reply *WorkflowUpdateValidateInvokeReply) CopyTo(target IProxyMessage) {
+	reply.WorkflowReply.CopyTo(target)
+}
@@ -0,0 +1,135 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_update_validate_invoke_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowUpdateValidateInvokeRequest is a WorkflowRequest of
+	// MessageType WorkflowUpdateValidateInvokeRequest.
+	//
+	// A WorkflowUpdateValidateInvokeRequest contains a reference to a
+	// WorkflowRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this WorkflowRequest
+	//
+	// A WorkflowUpdateValidateInvokeRequest is sent to the Neon.Temporal
+	// client to run a registered update's validator against the update's
+	// arguments. The client must reject the update, without mutating any
+	// workflow state, by returning an error on the reply when validation
+	// fails.
+	WorkflowUpdateValidateInvokeRequest struct {
+		*WorkflowRequest
+	}
+)
+
+// NewWorkflowUpdateValidateInvokeRequest is the default constructor for a
+// WorkflowUpdateValidateInvokeRequest
+//
+// returns *WorkflowUpdateValidateInvokeRequest -> a reference to a newly
+// initialized WorkflowUpdateValidateInvokeRequest in memory
+func NewWorkflowUpdateValidateInvokeRequest() *WorkflowUpdateValidateInvokeRequest {
+	request := new(WorkflowUpdateValidateInvokeRequest)
+	request.WorkflowRequest = NewWorkflowRequest()
+	request.SetType(internal.WorkflowUpdateValidateInvokeRequest)
+	request.SetReplyType(internal.WorkflowUpdateValidateInvokeReply)
+
+	return request
+}
+
+// GetUpdateName gets a WorkflowUpdateValidateInvokeRequest's UpdateName
+// value from its properties map. The name of the update handler whose
+// validator is being invoked.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowUpdateValidateInvokeRequest's UpdateName
+func (request *WorkflowUpdateValidateInvokeRequest) GetUpdateName() *string {
+	return request.GetStringProperty("UpdateName")
+}
+
+// SetUpdateName sets a WorkflowUpdateValidateInvokeRequest's UpdateName
+// value in its properties map. The name of the update handler whose
+// validator is being invoked.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowUpdateValidateInvokeRequest) SetUpdateName(value *string) {
+	request.SetStringProperty("UpdateName", value)
+}
+
+// GetUpdateID gets a WorkflowUpdateValidateInvokeRequest's UpdateID
+// value from its properties map. The id Temporal assigned to the update
+// being validated.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowUpdateValidateInvokeRequest's UpdateID
+func (request *WorkflowUpdateValidateInvokeRequest) GetUpdateID() *string {
+	return request.GetStringProperty("UpdateId")
+}
+
+// SetUpdateID sets a WorkflowUpdateValidateInvokeRequest's UpdateID
+// value in its properties map. The id Temporal assigned to the update
+// being validated.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowUpdateValidateInvokeRequest) SetUpdateID(value *string) {
+	request.SetStringProperty("UpdateId", value)
+}
+
+// GetArgs gets a WorkflowUpdateValidateInvokeRequest's Args field from
+// its properties map. Args is a []byte holding the arguments passed to
+// the update's validator.
+//
+// returns []byte -> a []byte representing the update arguments.
+func (request *WorkflowUpdateValidateInvokeRequest) GetArgs() []byte {
+	return request.GetBytesProperty("Args")
+}
+
+// SetArgs sets a WorkflowUpdateValidateInvokeRequest's Args field in
+// its properties map. Args is a []byte holding the arguments passed to
+// the update's validator.
+//
+// param value []byte -> a []byte representing the update arguments.
+func (request *WorkflowUpdateValidateInvokeRequest) SetArgs(value []byte) {
+	request.SetBytesProperty("Args", value)
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowRequest.Clone()
+func (request *WorkflowUpdateValidateInvokeRequest) Clone() IProxyMessage {
+	workflowUpdateValidateInvokeRequest := NewWorkflowUpdateValidateInvokeRequest()
+	var messageClone IProxyMessage = workflowUpdateValidateInvokeRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowRequest.CopyTo()
+func (request *WorkflowUpdateValidateInvokeRequest) CopyTo(target IProxyMessage) {
+	request.WorkflowRequest.CopyTo(target)
+	if v, ok := target.(*WorkflowUpdateValidateInvokeRequest); ok {
+		v.SetUpdateName(request.GetUpdateName())
+		v.SetUpdateID(request.GetUpdateID())
+		v.SetArgs(request.GetArgs())
+	}
+}
@@ -0,0 +1,253 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_update_with_start_request.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messages
+
+import (
+	"go.temporal.io/sdk/client"
+
+	internal "temporal-proxy/internal"
+)
+
+type (
+
+	// WorkflowUpdateWithStartRequest is a WorkflowRequest of MessageType
+	// WorkflowUpdateWithStartRequest.
+	//
+	// A WorkflowUpdateWithStartRequest contains a reference to a
+	// WorkflowRequest struct in memory and ReplyType, which is
+	// the corresponding MessageType for replying to this WorkflowRequest
+	//
+	// Starts a new workflow execution if one is not already running and
+	// sends it a Temporal Update in the same call, waiting for the update
+	// to reach the requested lifecycle stage.
+	WorkflowUpdateWithStartRequest struct {
+		*WorkflowRequest
+	}
+)
+
+// NewWorkflowUpdateWithStartRequest is the default constructor for a
+// WorkflowUpdateWithStartRequest
+//
+// returns *WorkflowUpdateWithStartRequest -> a reference to a newly
+// initialized WorkflowUpdateWithStartRequest in memory
+func NewWorkflowUpdateWithStartRequest() *WorkflowUpdateWithStartRequest {
+	request := new(WorkflowUpdateWithStartRequest)
+	request.WorkflowRequest = NewWorkflowRequest()
+	request.SetType(internal.WorkflowUpdateWithStartRequest)
+	request.SetReplyType(internal.WorkflowUpdateWithStartReply)
+
+	return request
+}
+
+// GetWorkflow gets a WorkflowUpdateWithStartRequest's Workflow value
+// from its properties map. The registered workflow type name to start.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowUpdateWithStartRequest's Workflow
+func (request *WorkflowUpdateWithStartRequest) GetWorkflow() *string {
+	return request.GetStringProperty("Workflow")
+}
+
+// SetWorkflow sets a WorkflowUpdateWithStartRequest's Workflow value
+// in its properties map. The registered workflow type name to start.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowUpdateWithStartRequest) SetWorkflow(value *string) {
+	request.SetStringProperty("Workflow", value)
+}
+
+// GetWorkflowID gets a WorkflowUpdateWithStartRequest's WorkflowID value
+// from its properties map. The workflowID to start the workflow with.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowUpdateWithStartRequest's WorkflowID
+func (request *WorkflowUpdateWithStartRequest) GetWorkflowID() *string {
+	return request.GetStringProperty("WorkflowId")
+}
+
+// SetWorkflowID sets a WorkflowUpdateWithStartRequest's WorkflowID value
+// in its properties map. The workflowID to start the workflow with.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowUpdateWithStartRequest) SetWorkflowID(value *string) {
+	request.SetStringProperty("WorkflowId", value)
+}
+
+// GetNamespace gets a WorkflowUpdateWithStartRequest's Namespace value
+// from its properties map.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowUpdateWithStartRequest's Namespace
+func (request *WorkflowUpdateWithStartRequest) GetNamespace() *string {
+	return request.GetStringProperty("Namespace")
+}
+
+// SetNamespace sets a WorkflowUpdateWithStartRequest's Namespace value
+// in its properties map.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowUpdateWithStartRequest) SetNamespace(value *string) {
+	request.SetStringProperty("Namespace", value)
+}
+
+// GetOptions gets a WorkflowUpdateWithStartRequest's StartWorkflowOptions
+// used to start the workflow if it is not already running.
+//
+// returns *client.StartWorkflowOptions -> the options to start the
+// workflow with.
+func (request *WorkflowUpdateWithStartRequest) GetOptions() *client.StartWorkflowOptions {
+	opts := new(client.StartWorkflowOptions)
+	err := request.GetJSONProperty("Options", opts)
+	if err != nil {
+		return nil
+	}
+
+	return opts
+}
+
+// SetOptions sets a WorkflowUpdateWithStartRequest's StartWorkflowOptions
+// used to start the workflow if it is not already running.
+//
+// param value *client.StartWorkflowOptions -> the options to start the
+// workflow with.
+func (request *WorkflowUpdateWithStartRequest) SetOptions(value *client.StartWorkflowOptions) {
+	request.SetJSONProperty("Options", value)
+}
+
+// GetWorkflowArgs gets a WorkflowUpdateWithStartRequest's WorkflowArgs
+// field from its properties map. The arguments to start the workflow
+// with.
+//
+// returns []byte -> a []byte representing the workflow start arguments.
+func (request *WorkflowUpdateWithStartRequest) GetWorkflowArgs() []byte {
+	return request.GetBytesProperty("WorkflowArgs")
+}
+
+// SetWorkflowArgs sets a WorkflowUpdateWithStartRequest's WorkflowArgs
+// field in its properties map. The arguments to start the workflow
+// with.
+//
+// param value []byte -> a []byte representing the workflow start arguments.
+func (request *WorkflowUpdateWithStartRequest) SetWorkflowArgs(value []byte) {
+	request.SetBytesProperty("WorkflowArgs", value)
+}
+
+// GetUpdateName gets a WorkflowUpdateWithStartRequest's UpdateName value
+// from its properties map. The name of the update handler to invoke.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowUpdateWithStartRequest's UpdateName
+func (request *WorkflowUpdateWithStartRequest) GetUpdateName() *string {
+	return request.GetStringProperty("UpdateName")
+}
+
+// SetUpdateName sets a WorkflowUpdateWithStartRequest's UpdateName value
+// in its properties map. The name of the update handler to invoke.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowUpdateWithStartRequest) SetUpdateName(value *string) {
+	request.SetStringProperty("UpdateName", value)
+}
+
+// GetUpdateID gets a WorkflowUpdateWithStartRequest's UpdateID value
+// from its properties map. The caller supplied id used to deduplicate
+// the update; a new id is assigned by Temporal when nil.
+//
+// returns *string -> pointer to a string in memory holding the value
+// of a WorkflowUpdateWithStartRequest's UpdateID
+func (request *WorkflowUpdateWithStartRequest) GetUpdateID() *string {
+	return request.GetStringProperty("UpdateId")
+}
+
+// SetUpdateID sets a WorkflowUpdateWithStartRequest's UpdateID value
+// in its properties map. The caller supplied id used to deduplicate
+// the update; a new id is assigned by Temporal when nil.
+//
+// param value *string -> a pointer to a string in memory that holds the value
+// to be set in the properties map
+func (request *WorkflowUpdateWithStartRequest) SetUpdateID(value *string) {
+	request.SetStringProperty("UpdateId", value)
+}
+
+// GetUpdateArgs gets a WorkflowUpdateWithStartRequest's UpdateArgs field
+// from its properties map. The arguments for the update handler.
+//
+// returns []byte -> a []byte representing the update arguments.
+func (request *WorkflowUpdateWithStartRequest) GetUpdateArgs() []byte {
+	return request.GetBytesProperty("UpdateArgs")
+}
+
+// SetUpdateArgs sets a WorkflowUpdateWithStartRequest's UpdateArgs field
+// in its properties map. The arguments for the update handler.
+//
+// param value []byte -> a []byte representing the update arguments.
+func (request *WorkflowUpdateWithStartRequest) SetUpdateArgs(value []byte) {
+	request.SetBytesProperty("UpdateArgs", value)
+}
+
+// GetWaitForStage gets a WorkflowUpdateWithStartRequest's WaitForStage
+// value from its properties map. The lifecycle stage (Admitted,
+// Accepted, or Completed) the caller wants the update to reach before
+// this request returns.
+//
+// returns client.WorkflowUpdateStage -> the lifecycle stage to wait for.
+func (request *WorkflowUpdateWithStartRequest) GetWaitForStage() client.WorkflowUpdateStage {
+	return client.WorkflowUpdateStage(request.GetIntProperty("WaitForStage"))
+}
+
+// SetWaitForStage sets a WorkflowUpdateWithStartRequest's WaitForStage
+// value in its properties map. The lifecycle stage (Admitted,
+// Accepted, or Completed) the caller wants the update to reach before
+// this request returns.
+//
+// param value client.WorkflowUpdateStage -> the lifecycle stage to wait for.
+func (request *WorkflowUpdateWithStartRequest) SetWaitForStage(value client.WorkflowUpdateStage) {
+	request.SetIntProperty("WaitForStage", int32(value))
+}
+
+// -------------------------------------------------------------------------
+// IProxyMessage interface methods for implementing the IProxyMessage interface
+
+// Clone inherits docs from WorkflowRequest.Clone()
+func (request *WorkflowUpdateWithStartRequest) Clone() IProxyMessage {
+	workflowUpdateWithStartRequest := NewWorkflowUpdateWithStartRequest()
+	var messageClone IProxyMessage = workflowUpdateWithStartRequest
+	request.CopyTo(messageClone)
+
+	return messageClone
+}
+
+// CopyTo inherits docs from WorkflowRequest.CopyTo()
+func (request *WorkflowUpdateWithStartRequest) CopyTo(target IProxyMessage) {
+	request.WorkflowRequest.CopyTo(target)
+	if v, ok := target.(*WorkflowUpdateWithStartRequest); ok {
+		v.SetWorkflow(request.GetWorkflow())
+		v.SetWorkflowID(request.GetWorkflowID())
+		v.SetNamespace(request.GetNamespace())
+		v.SetOptions(request.GetOptions())
+		v.SetWorkflowArgs(request.GetWorkflowArgs())
+		v.SetUpdateName(request.GetUpdateName())
+		v.SetUpdateID(request.GetUpdateID())
+		v.SetUpdateArgs(request.GetUpdateArgs())
+		v.SetWaitForStage(request.GetWaitForStage())
+	}
+}
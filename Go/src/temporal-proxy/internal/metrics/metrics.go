@@ -0,0 +1,102 @@
+//-----------------------------------------------------------------------------
+// FILE:		metrics.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics collects Prometheus metrics for the proxy's inbound
+// message dispatch loop: how many messages of each type were processed,
+// how long dispatch took, how many replies are outstanding waiting on a
+// PUT back to the Neon.Temporal client, and how often that PUT fails.
+//
+// NOTE: the dispatch loop these metrics are meant to be recorded from
+// (proccessIncomingMessage/putReply, per the request that motivated this
+// package) isn't present in this snapshot -- only the leaf
+// handlers/messages/client packages are. Registry is written against
+// that loop's description so it's ready to wire in once it exists;
+// there is deliberately no call site added here.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Registry owns one isolated set of dispatch-loop metrics and the
+// prometheus.Registry they're registered against, rather than relying
+// on the global DefaultRegisterer so tests can construct their own.
+type Registry struct {
+	registry *prometheus.Registry
+
+	// MessagesProcessed counts dispatched ProxyMessages by MessageType.
+	MessagesProcessed *prometheus.CounterVec
+
+	// DispatchLatency observes how long proccessIncomingMessage took to
+	// handle a ProxyMessage, by MessageType.
+	DispatchLatency *prometheus.HistogramVec
+
+	// OutstandingReplies is the current count of replies that have been
+	// built but not yet successfully PUT back to the Neon.Temporal
+	// client.
+	OutstandingReplies prometheus.Gauge
+
+	// PutReplyFailures counts failed attempts to PUT a reply back to the
+	// Neon.Temporal client.
+	PutReplyFailures prometheus.Counter
+}
+
+// NewRegistry is the default constructor for a Registry. It creates a
+// fresh prometheus.Registry and registers all of its metrics against it.
+//
+// returns *Registry -> a pointer to a new Registry in memory.
+func NewRegistry() *Registry {
+	r := &Registry{
+		registry: prometheus.NewRegistry(),
+		MessagesProcessed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "temporal_proxy",
+			Name:      "messages_processed_total",
+			Help:      "Total number of ProxyMessages processed, by MessageType.",
+		}, []string{"type"}),
+		DispatchLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "temporal_proxy",
+			Name:      "dispatch_latency_seconds",
+			Help:      "Time spent in proccessIncomingMessage, by MessageType.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"type"}),
+		OutstandingReplies: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "temporal_proxy",
+			Name:      "outstanding_replies",
+			Help:      "Number of replies built but not yet successfully PUT back to the Neon.Temporal client.",
+		}),
+		PutReplyFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "temporal_proxy",
+			Name:      "put_reply_failures_total",
+			Help:      "Total number of failed attempts to PUT a reply back to the Neon.Temporal client.",
+		}),
+	}
+
+	r.registry.MustRegister(r.MessagesProcessed, r.DispatchLatency, r.OutstandingReplies, r.PutReplyFailures)
+
+	return r
+}
+
+// Handler returns the http.Handler that serves r's metrics in the
+// Prometheus exposition format, for mounting at a /metrics route.
+//
+// returns http.Handler -> the handler to mount.
+func (r *Registry) Handler() http.Handler {
+	return promhttp.HandlerFor(r.registry, promhttp.HandlerOpts{})
+}
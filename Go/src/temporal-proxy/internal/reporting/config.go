@@ -0,0 +1,65 @@
+//-----------------------------------------------------------------------------
+// FILE:		config.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporting
+
+// Config selects and configures the ErrorReporter NewReporter builds.
+// It's meant to be populated from the proxy's own startup args (e.g.
+// --error-reporting-dsn, --error-reporting-otel-endpoint,
+// --error-reporting-sample-rate), none of which exist in this snapshot
+// yet -- see the NOTE on NewReporter.
+type Config struct {
+
+	// SentryDSN, if set, selects a SentryReporter.
+	SentryDSN string
+
+	// OTelEndpoint, if set and SentryDSN is not, selects an
+	// OTelReporter.
+	OTelEndpoint string
+
+	// SampleRate is the fraction of reported errors actually sent to
+	// the backend, in [0, 1]. Backends that don't support sampling
+	// ignore it.
+	SampleRate float64
+}
+
+// NewReporter builds the ErrorReporter cfg selects: a SentryReporter if
+// SentryDSN is set, an OTelReporter if OTelEndpoint is set, or a
+// NoopReporter if neither is.
+//
+// NOTE: there's no proxy startup flag parsing in this snapshot to read
+// cfg's fields from -- this is written so that whichever flag parsing
+// eventually exists only needs to populate a Config and call
+// reporting.SetDefault(reporting.NewReporter(cfg)).
+//
+// param cfg Config -> the reporting configuration to build from.
+//
+// returns:
+//	- ErrorReporter -> the constructed ErrorReporter.
+// 	- error -> any error initializing the selected backend.
+func NewReporter(cfg Config) (ErrorReporter, error) {
+	switch {
+	case cfg.SentryDSN != "":
+		return NewSentryReporter(cfg.SentryDSN, cfg.SampleRate)
+
+	case cfg.OTelEndpoint != "":
+		return NewOTelReporter(cfg.OTelEndpoint), nil
+
+	default:
+		return NoopReporter{}, nil
+	}
+}
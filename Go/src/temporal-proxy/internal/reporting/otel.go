@@ -0,0 +1,64 @@
+//-----------------------------------------------------------------------------
+// FILE:		otel.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporting
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OTelReporter is an ErrorReporter that records each error as a span
+// event (RecordError) on whatever span ctx carries, rather than
+// starting one of its own.
+//
+// NOTE: endpoint is kept only to report where events are headed; wiring
+// it up to a real exporter/TracerProvider is left to whoever configures
+// OpenTelemetry for this proxy process, since that's a process-wide
+// concern this package shouldn't own.
+type OTelReporter struct {
+	endpoint string
+}
+
+// NewOTelReporter is the default constructor for an OTelReporter.
+//
+// param endpoint string -> the OpenTelemetry collector endpoint events
+// are ultimately exported to, by the process's own configured
+// TracerProvider.
+//
+// returns *OTelReporter -> a pointer to a new OTelReporter in memory.
+func NewOTelReporter(endpoint string) *OTelReporter {
+	return &OTelReporter{endpoint: endpoint}
+}
+
+// Report inherits docs from ErrorReporter.Report.
+func (r *OTelReporter) Report(ctx context.Context, err error, tags map[string]string) {
+	attrs := make([]attribute.KeyValue, 0, len(tags))
+	for k, v := range tags {
+		attrs = append(attrs, attribute.String(k, v))
+	}
+
+	trace.SpanFromContext(ctx).RecordError(err, trace.WithAttributes(attrs...))
+}
+
+// Flush inherits docs from ErrorReporter.Flush. Span events are flushed
+// by the process's own TracerProvider, not this reporter, so this
+// always succeeds immediately.
+func (r *OTelReporter) Flush(timeout time.Duration) bool { return true }
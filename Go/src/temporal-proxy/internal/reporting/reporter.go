@@ -0,0 +1,71 @@
+//-----------------------------------------------------------------------------
+// FILE:		reporter.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package reporting gives handler errors that would otherwise only hit a
+// Logger.Debug line somewhere an operator can actually see them, via a
+// pluggable ErrorReporter backend (Sentry, OpenTelemetry span events, or
+// a no-op default).
+package reporting
+
+import (
+	"context"
+	"time"
+)
+
+type (
+
+	// ErrorReporter is a pluggable sink for handler errors, tagged with
+	// context (message type, request id, and whatever else a caller
+	// knows about the failure) so an operator can see and filter them,
+	// rather than the error only ever reaching a debug log line.
+	ErrorReporter interface {
+
+		// Report records err, annotated with tags, against whatever
+		// backend this ErrorReporter wraps.
+		Report(ctx context.Context, err error, tags map[string]string)
+
+		// Flush blocks up to timeout for any buffered events to be
+		// delivered, returning false if it gave up before they were.
+		Flush(timeout time.Duration) bool
+	}
+
+	// NoopReporter is the default ErrorReporter -- it discards
+	// everything reported to it. Used until SetDefault is called with a
+	// real backend during proxy startup.
+	NoopReporter struct{}
+)
+
+// Report inherits docs from ErrorReporter.Report. It does nothing.
+func (NoopReporter) Report(ctx context.Context, err error, tags map[string]string) {}
+
+// Flush inherits docs from ErrorReporter.Flush. It always succeeds
+// immediately.
+func (NoopReporter) Flush(timeout time.Duration) bool { return true }
+
+// Default is the process-wide ErrorReporter every handler error is
+// reported to. It starts out as a NoopReporter; proxy startup should
+// call SetDefault once it has parsed its ErrorReporting Config.
+var Default ErrorReporter = NoopReporter{}
+
+// SetDefault replaces Default with reporter, for proxy startup to call
+// once it has built the ErrorReporter its Config selects.
+//
+// param reporter ErrorReporter -> the ErrorReporter to report to from
+// this point forward.
+func SetDefault(reporter ErrorReporter) {
+	Default = reporter
+}
@@ -0,0 +1,65 @@
+//-----------------------------------------------------------------------------
+// FILE:		sentry.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporting
+
+import (
+	"context"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// SentryReporter is an ErrorReporter backed by Sentry.
+type SentryReporter struct{}
+
+// NewSentryReporter is the default constructor for a SentryReporter. It
+// initializes the global Sentry client against dsn.
+//
+// params:
+//	- dsn string -> the Sentry project DSN to report to.
+// 	- sampleRate float64 -> the fraction of errors actually sent to
+//	Sentry, in [0, 1].
+//
+// returns:
+//	- *SentryReporter -> a pointer to a new SentryReporter in memory.
+// 	- error -> any error initializing the Sentry client.
+func NewSentryReporter(dsn string, sampleRate float64) (*SentryReporter, error) {
+	err := sentry.Init(sentry.ClientOptions{
+		Dsn:        dsn,
+		SampleRate: sampleRate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SentryReporter{}, nil
+}
+
+// Report inherits docs from ErrorReporter.Report.
+func (r *SentryReporter) Report(ctx context.Context, err error, tags map[string]string) {
+	hub := sentry.CurrentHub().Clone()
+	hub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetTags(tags)
+	})
+	hub.CaptureException(err)
+}
+
+// Flush inherits docs from ErrorReporter.Flush.
+func (r *SentryReporter) Flush(timeout time.Duration) bool {
+	return sentry.Flush(timeout)
+}
@@ -0,0 +1,118 @@
+//-----------------------------------------------------------------------------
+// FILE:		config.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+
+	// DriverMemory selects the in-memory ContextStore. This is the
+	// default when no StorageConfig is supplied.
+	DriverMemory = "memory"
+
+	// DriverEtcd selects the etcd v3-backed ContextStore.
+	DriverEtcd = "etcd"
+)
+
+// StorageConfig configures which ContextStore NewContextStore builds and
+// how it connects. Existing deployments that don't set Driver keep
+// today's in-memory, restart-unsafe behavior without any code changes.
+type StorageConfig struct {
+
+	// Driver selects the ContextStore implementation: DriverMemory
+	// (default) or DriverEtcd.
+	Driver string
+
+	// Endpoints is the list of etcd cluster member addresses. Only used
+	// when Driver is DriverEtcd.
+	Endpoints []string
+
+	// DialTimeout bounds how long NewContextStore waits to establish the
+	// etcd connection. Only used when Driver is DriverEtcd.
+	DialTimeout time.Duration
+
+	// TLSCertFile, TLSKeyFile, and TLSCAFile configure mutual TLS against
+	// the etcd cluster. Leave TLSCertFile empty to connect without TLS.
+	// Only used when Driver is DriverEtcd.
+	TLSCertFile string
+	TLSKeyFile  string
+	TLSCAFile   string
+
+	// Prefix scopes every key this store writes, letting multiple
+	// temporal-proxy deployments share an etcd cluster without
+	// colliding. Defaults to "/temporal-proxy" when empty.
+	Prefix string
+
+	// TTL is the lease duration attached to each persisted
+	// ContextRecord so a proxy that crashes without cleaning up doesn't
+	// leak records forever. Zero disables leasing. Only used when Driver
+	// is DriverEtcd.
+	TTL time.Duration
+}
+
+// NewContextStore builds the ContextStore selected by config.Driver,
+// defaulting to an in-memory store when config.Driver is empty.
+//
+// param config StorageConfig -> the storage backend to build.
+//
+// returns:
+//	- ContextStore -> the constructed store.
+// 	- error -> an error, if one occurred building the store.
+func NewContextStore(config StorageConfig) (ContextStore, error) {
+	if config.Prefix == "" {
+		config.Prefix = "/temporal-proxy"
+	}
+
+	switch config.Driver {
+	case "", DriverMemory:
+		return NewMemoryContextStore(), nil
+	case DriverEtcd:
+		return NewEtcdContextStore(config)
+	default:
+		return nil, fmt.Errorf("store: unknown driver %q", config.Driver)
+	}
+}
+
+// NewOperationStore builds the OperationStore selected by config.Driver,
+// defaulting to an in-memory store when config.Driver is empty. It
+// shares StorageConfig and the DriverMemory/DriverEtcd constants with
+// NewContextStore, since both stores are typically pointed at the same
+// backend.
+//
+// param config StorageConfig -> the storage backend to build.
+//
+// returns:
+//	- OperationStore -> the constructed store.
+// 	- error -> an error, if one occurred building the store.
+func NewOperationStore(config StorageConfig) (OperationStore, error) {
+	if config.Prefix == "" {
+		config.Prefix = "/temporal-proxy"
+	}
+
+	switch config.Driver {
+	case "", DriverMemory:
+		return NewMemoryOperationStore(), nil
+	case DriverEtcd:
+		return NewEtcdOperationStore(config)
+	default:
+		return nil, fmt.Errorf("store: unknown driver %q", config.Driver)
+	}
+}
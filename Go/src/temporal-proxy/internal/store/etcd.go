@@ -0,0 +1,316 @@
+//-----------------------------------------------------------------------------
+// FILE:		etcd.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// EtcdContextStore is a ContextStore backed by etcd v3. Each record is
+// written under a lease so it expires on its own if the proxy crashes
+// without cleaning up, and every write is scoped under Prefix so
+// multiple proxy deployments can share a cluster.
+type EtcdContextStore struct {
+	client *clientv3.Client
+	prefix string
+	ttl    time.Duration
+}
+
+// NewEtcdContextStore dials an etcd cluster and returns a ContextStore
+// backed by it.
+//
+// param config StorageConfig -> the etcd connection and prefix/TTL
+// settings to use.
+//
+// returns:
+//	- *EtcdContextStore -> a pointer to a new EtcdContextStore in memory.
+// 	- error -> an error, if one occurred dialing the etcd cluster.
+func NewEtcdContextStore(config StorageConfig) (*EtcdContextStore, error) {
+	etcdConfig := clientv3.Config{
+		Endpoints:   config.Endpoints,
+		DialTimeout: config.DialTimeout,
+	}
+
+	if config.TLSCertFile != "" {
+		tlsConfig, err := buildTLSConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		etcdConfig.TLS = tlsConfig
+	}
+
+	client, err := clientv3.New(etcdConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EtcdContextStore{
+		client: client,
+		prefix: config.Prefix,
+		ttl:    config.TTL,
+	}, nil
+}
+
+func buildTLSConfig(config StorageConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(config.TLSCertFile, config.TLSKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if config.TLSCAFile != "" {
+		ca, err := ioutil.ReadFile(config.TLSCAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool.AppendCertsFromPEM(ca)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+	}, nil
+}
+
+// key builds the etcd key for clientID/contextID:
+// /<prefix>/wfctx/<clientID>/<contextID>
+func (store *EtcdContextStore) key(clientID int64, contextID int64) string {
+	return fmt.Sprintf("%s/wfctx/%d/%d", store.prefix, clientID, contextID)
+}
+
+// listPrefix builds the etcd key prefix scoping every record owned by
+// clientID: /<prefix>/wfctx/<clientID>/
+func (store *EtcdContextStore) listPrefix(clientID int64) string {
+	return fmt.Sprintf("%s/wfctx/%d/", store.prefix, clientID)
+}
+
+// Put inherits docs from ContextStore.Put.
+func (store *EtcdContextStore) Put(ctx context.Context, record ContextRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	opts := []clientv3.OpOption{}
+	if store.ttl > 0 {
+		lease, err := store.client.Grant(ctx, int64(store.ttl.Seconds()))
+		if err != nil {
+			return err
+		}
+		opts = append(opts, clientv3.WithLease(lease.ID))
+	}
+
+	_, err = store.client.Put(ctx, store.key(record.ClientID, record.ContextID), string(data), opts...)
+
+	return err
+}
+
+// Get inherits docs from ContextStore.Get.
+func (store *EtcdContextStore) Get(ctx context.Context, clientID int64, contextID int64) (ContextRecord, error) {
+	resp, err := store.client.Get(ctx, store.key(clientID, contextID))
+	if err != nil {
+		return ContextRecord{}, err
+	}
+
+	if len(resp.Kvs) == 0 {
+		return ContextRecord{}, ErrContextRecordNotFound
+	}
+
+	var record ContextRecord
+	if err := json.Unmarshal(resp.Kvs[0].Value, &record); err != nil {
+		return ContextRecord{}, err
+	}
+
+	return record, nil
+}
+
+// Delete inherits docs from ContextStore.Delete.
+func (store *EtcdContextStore) Delete(ctx context.Context, clientID int64, contextID int64) error {
+	_, err := store.client.Delete(ctx, store.key(clientID, contextID))
+
+	return err
+}
+
+// List inherits docs from ContextStore.List.
+func (store *EtcdContextStore) List(ctx context.Context, clientID int64) ([]ContextRecord, error) {
+	resp, err := store.client.Get(ctx, store.listPrefix(clientID), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]ContextRecord, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var record ContextRecord
+		if err := json.Unmarshal(kv.Value, &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// Close releases the underlying etcd client connection.
+//
+// returns error -> an error, if one occurred closing the connection.
+func (store *EtcdContextStore) Close() error {
+	return store.client.Close()
+}
+
+// EtcdOperationStore is an OperationStore backed by etcd v3, sharing the
+// same durable-storage technology EtcdContextStore uses rather than
+// introducing a second dependency (e.g. BoltDB for single-node
+// persistence, Redis for a shared replica set) this tree doesn't
+// otherwise use. Like EtcdContextStore, each record is written under a
+// lease so it expires on its own if the proxy crashes without cleaning
+// up, and every write is scoped under Prefix so multiple proxy
+// deployments -- or multiple replicas of the same deployment -- can
+// share a cluster.
+type EtcdOperationStore struct {
+	client *clientv3.Client
+	prefix string
+	ttl    time.Duration
+}
+
+// NewEtcdOperationStore dials an etcd cluster and returns an
+// OperationStore backed by it.
+//
+// param config StorageConfig -> the etcd connection and prefix/TTL
+// settings to use.
+//
+// returns:
+//	- *EtcdOperationStore -> a pointer to a new EtcdOperationStore in memory.
+// 	- error -> an error, if one occurred dialing the etcd cluster.
+func NewEtcdOperationStore(config StorageConfig) (*EtcdOperationStore, error) {
+	etcdConfig := clientv3.Config{
+		Endpoints:   config.Endpoints,
+		DialTimeout: config.DialTimeout,
+	}
+
+	if config.TLSCertFile != "" {
+		tlsConfig, err := buildTLSConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		etcdConfig.TLS = tlsConfig
+	}
+
+	client, err := clientv3.New(etcdConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EtcdOperationStore{
+		client: client,
+		prefix: config.Prefix,
+		ttl:    config.TTL,
+	}, nil
+}
+
+// key builds the etcd key for clientID/requestID:
+// /<prefix>/op/<clientID>/<requestID>
+func (store *EtcdOperationStore) key(clientID int64, requestID int64) string {
+	return fmt.Sprintf("%s/op/%d/%d", store.prefix, clientID, requestID)
+}
+
+// listPrefix builds the etcd key prefix scoping every record owned by
+// clientID: /<prefix>/op/<clientID>/
+func (store *EtcdOperationStore) listPrefix(clientID int64) string {
+	return fmt.Sprintf("%s/op/%d/", store.prefix, clientID)
+}
+
+// Put inherits docs from OperationStore.Put.
+func (store *EtcdOperationStore) Put(ctx context.Context, record OperationRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	opts := []clientv3.OpOption{}
+	if store.ttl > 0 {
+		lease, err := store.client.Grant(ctx, int64(store.ttl.Seconds()))
+		if err != nil {
+			return err
+		}
+		opts = append(opts, clientv3.WithLease(lease.ID))
+	}
+
+	_, err = store.client.Put(ctx, store.key(record.ClientID, record.RequestID), string(data), opts...)
+
+	return err
+}
+
+// Get inherits docs from OperationStore.Get.
+func (store *EtcdOperationStore) Get(ctx context.Context, clientID int64, requestID int64) (OperationRecord, error) {
+	resp, err := store.client.Get(ctx, store.key(clientID, requestID))
+	if err != nil {
+		return OperationRecord{}, err
+	}
+
+	if len(resp.Kvs) == 0 {
+		return OperationRecord{}, ErrOperationRecordNotFound
+	}
+
+	var record OperationRecord
+	if err := json.Unmarshal(resp.Kvs[0].Value, &record); err != nil {
+		return OperationRecord{}, err
+	}
+
+	return record, nil
+}
+
+// Delete inherits docs from OperationStore.Delete.
+func (store *EtcdOperationStore) Delete(ctx context.Context, clientID int64, requestID int64) error {
+	_, err := store.client.Delete(ctx, store.key(clientID, requestID))
+
+	return err
+}
+
+// List inherits docs from OperationStore.List.
+func (store *EtcdOperationStore) List(ctx context.Context, clientID int64) ([]OperationRecord, error) {
+	resp, err := store.client.Get(ctx, store.listPrefix(clientID), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]OperationRecord, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var record OperationRecord
+		if err := json.Unmarshal(kv.Value, &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// Close releases the underlying etcd client connection.
+//
+// returns error -> an error, if one occurred closing the connection.
+func (store *EtcdOperationStore) Close() error {
+	return store.client.Close()
+}
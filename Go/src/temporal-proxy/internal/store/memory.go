@@ -0,0 +1,94 @@
+//-----------------------------------------------------------------------------
+// FILE:		memory.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// MemoryContextStore is the default ContextStore, backed by a mutex-
+// guarded map. It does not survive a process restart; it exists so the
+// proxy always has a working ContextStore even when no external driver
+// is configured, and so tests can exercise rehydration without standing
+// up etcd.
+type MemoryContextStore struct {
+	sync.Mutex
+	records map[string]ContextRecord
+}
+
+// NewMemoryContextStore is the default constructor for a
+// MemoryContextStore.
+//
+// returns *MemoryContextStore -> a pointer to a new MemoryContextStore in memory.
+func NewMemoryContextStore() *MemoryContextStore {
+	store := new(MemoryContextStore)
+	store.records = make(map[string]ContextRecord)
+
+	return store
+}
+
+func memoryKey(clientID int64, contextID int64) string {
+	return fmt.Sprintf("%d/%d", clientID, contextID)
+}
+
+// Put inherits docs from ContextStore.Put.
+func (store *MemoryContextStore) Put(ctx context.Context, record ContextRecord) error {
+	store.Lock()
+	defer store.Unlock()
+	store.records[memoryKey(record.ClientID, record.ContextID)] = record
+
+	return nil
+}
+
+// Get inherits docs from ContextStore.Get.
+func (store *MemoryContextStore) Get(ctx context.Context, clientID int64, contextID int64) (ContextRecord, error) {
+	store.Lock()
+	defer store.Unlock()
+	record, ok := store.records[memoryKey(clientID, contextID)]
+	if !ok {
+		return ContextRecord{}, ErrContextRecordNotFound
+	}
+
+	return record, nil
+}
+
+// Delete inherits docs from ContextStore.Delete.
+func (store *MemoryContextStore) Delete(ctx context.Context, clientID int64, contextID int64) error {
+	store.Lock()
+	defer store.Unlock()
+	delete(store.records, memoryKey(clientID, contextID))
+
+	return nil
+}
+
+// List inherits docs from ContextStore.List.
+func (store *MemoryContextStore) List(ctx context.Context, clientID int64) ([]ContextRecord, error) {
+	store.Lock()
+	defer store.Unlock()
+
+	records := make([]ContextRecord, 0, len(store.records))
+	for _, record := range store.records {
+		if record.ClientID == clientID {
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
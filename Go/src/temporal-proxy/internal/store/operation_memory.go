@@ -0,0 +1,93 @@
+//-----------------------------------------------------------------------------
+// FILE:		operation_memory.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// MemoryOperationStore is the default OperationStore, backed by a mutex-
+// guarded map. It does not survive a process restart; it exists so the
+// proxy always has a working OperationStore even when no external driver
+// is configured, the same role MemoryContextStore plays for ContextStore.
+type MemoryOperationStore struct {
+	sync.Mutex
+	records map[string]OperationRecord
+}
+
+// NewMemoryOperationStore is the default constructor for a
+// MemoryOperationStore.
+//
+// returns *MemoryOperationStore -> a pointer to a new MemoryOperationStore in memory.
+func NewMemoryOperationStore() *MemoryOperationStore {
+	store := new(MemoryOperationStore)
+	store.records = make(map[string]OperationRecord)
+
+	return store
+}
+
+func operationKey(clientID int64, requestID int64) string {
+	return fmt.Sprintf("%d/%d", clientID, requestID)
+}
+
+// Put inherits docs from OperationStore.Put.
+func (store *MemoryOperationStore) Put(ctx context.Context, record OperationRecord) error {
+	store.Lock()
+	defer store.Unlock()
+	store.records[operationKey(record.ClientID, record.RequestID)] = record
+
+	return nil
+}
+
+// Get inherits docs from OperationStore.Get.
+func (store *MemoryOperationStore) Get(ctx context.Context, clientID int64, requestID int64) (OperationRecord, error) {
+	store.Lock()
+	defer store.Unlock()
+	record, ok := store.records[operationKey(clientID, requestID)]
+	if !ok {
+		return OperationRecord{}, ErrOperationRecordNotFound
+	}
+
+	return record, nil
+}
+
+// Delete inherits docs from OperationStore.Delete.
+func (store *MemoryOperationStore) Delete(ctx context.Context, clientID int64, requestID int64) error {
+	store.Lock()
+	defer store.Unlock()
+	delete(store.records, operationKey(clientID, requestID))
+
+	return nil
+}
+
+// List inherits docs from OperationStore.List.
+func (store *MemoryOperationStore) List(ctx context.Context, clientID int64) ([]OperationRecord, error) {
+	store.Lock()
+	defer store.Unlock()
+
+	records := make([]OperationRecord, 0, len(store.records))
+	for _, record := range store.records {
+		if record.ClientID == clientID {
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
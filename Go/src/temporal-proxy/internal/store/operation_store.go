@@ -0,0 +1,84 @@
+//-----------------------------------------------------------------------------
+// FILE:		operation_store.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrOperationRecordNotFound is returned by OperationStore.Get when no
+// record exists for the requested client/request pair.
+var ErrOperationRecordNotFound = errors.New("store: operation record not found")
+
+type (
+
+	// OperationRecord is the durable bookkeeping record for one
+	// in-flight Operation (see handlers.Operation), persisted so a
+	// restarted proxy can recognize which invoke requests it was still
+	// awaiting a reply for when it went down, the same way ContextRecord
+	// does for open WorkflowContexts.
+	OperationRecord struct {
+
+		// ClientID is the Neon.Temporal client instance this Operation
+		// was sent on behalf of.
+		ClientID int64
+
+		// RequestID is the ID the Operation is registered under.
+		RequestID int64
+
+		// ContextID is the WorkflowContextID this Operation belongs to,
+		// zero if it doesn't belong to one (e.g. an ActivityRegisterRequest's
+		// Operation).
+		ContextID int64
+
+		// RequestData is the originating invoke request, serialized with
+		// messages.Serialize, kept so it can be resent on replay without
+		// the caller having to reconstruct it.
+		RequestData []byte
+
+		// CreatedAt is when the record was written, used to recognize
+		// one that's outlived any reasonable reply deadline on replay.
+		CreatedAt time.Time
+	}
+
+	// OperationStore persists OperationRecords so in-flight requests
+	// survive a proxy restart instead of leaving their caller blocked on
+	// a channel nothing will ever deliver to again. Implementations must
+	// be safe for concurrent use.
+	OperationStore interface {
+
+		// Put durably writes record, replacing any existing record for
+		// the same ClientID/RequestID.
+		Put(ctx context.Context, record OperationRecord) error
+
+		// Get returns the record for clientID/requestID, or
+		// ErrOperationRecordNotFound if none exists.
+		Get(ctx context.Context, clientID int64, requestID int64) (OperationRecord, error)
+
+		// Delete removes the record for clientID/requestID, if any. It
+		// is not an error to delete a record that doesn't exist.
+		Delete(ctx context.Context, clientID int64, requestID int64) error
+
+		// List returns every record currently persisted for clientID,
+		// typically called once at startup to discover outstanding
+		// Operations a restarted proxy never settled.
+		List(ctx context.Context, clientID int64) ([]OperationRecord, error)
+	}
+)
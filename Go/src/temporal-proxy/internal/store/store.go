@@ -0,0 +1,91 @@
+//-----------------------------------------------------------------------------
+// FILE:		store.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package store persists the bookkeeping the temporal-proxy needs to
+// survive a restart while operations are in flight: which ContextIDs are
+// open, which RequestID a pending reply is expected to settle, and which
+// client they belong to. It does not, and cannot, persist the Go channel
+// an Operation settles through -- that's process-local and dies with the
+// process. What it buys a restarted proxy is the ability to recognize a
+// late reply for a context, or an in-flight Operation, that no longer
+// exists in memory and log/clean it up deliberately instead of it
+// falling through to ErrEntityNotExist silently, or leaving its caller
+// blocked forever. See ContextStore/MemoryContextStore/EtcdContextStore
+// and their OperationStore/MemoryOperationStore/EtcdOperationStore
+// counterparts.
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrContextRecordNotFound is returned by ContextStore.Get when no record
+// exists for the requested client/context pair.
+var ErrContextRecordNotFound = errors.New("store: context record not found")
+
+type (
+
+	// ContextRecord is the durable bookkeeping record for one open
+	// WorkflowContext, persisted so a restarted proxy
+	// can recognize a late reply for work it no longer holds in memory.
+	ContextRecord struct {
+
+		// ClientID is the Neon.Temporal client instance that owns this context.
+		ClientID int64
+
+		// ContextID is the ID of the WorkflowContext.
+		ContextID int64
+
+		// RequestID is the ID of the outstanding invoke request a reply
+		// is expected to settle.
+		RequestID int64
+
+		// WorkflowName is the workflow type name, kept for
+		// diagnostics when a record is rehydrated.
+		WorkflowName string
+
+		// Namespace is the Temporal namespace the context belongs to.
+		Namespace string
+
+		// CreatedAt is when the record was written, used to age out
+		// stale records a driver never got around to deleting.
+		CreatedAt time.Time
+	}
+
+	// ContextStore persists ContextRecords so they survive a proxy
+	// restart. Implementations must be safe for concurrent use.
+	ContextStore interface {
+
+		// Put durably writes record, replacing any existing record for
+		// the same ClientID/ContextID.
+		Put(ctx context.Context, record ContextRecord) error
+
+		// Get returns the record for clientID/contextID, or
+		// ErrContextRecordNotFound if none exists.
+		Get(ctx context.Context, clientID int64, contextID int64) (ContextRecord, error)
+
+		// Delete removes the record for clientID/contextID, if any. It
+		// is not an error to delete a record that doesn't exist.
+		Delete(ctx context.Context, clientID int64, contextID int64) error
+
+		// List returns every record currently persisted for clientID,
+		// typically called once at startup to rehydrate pending work.
+		List(ctx context.Context, clientID int64) ([]ContextRecord, error)
+	}
+)
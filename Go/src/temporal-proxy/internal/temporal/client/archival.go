@@ -0,0 +1,59 @@
+//-----------------------------------------------------------------------------
+// FILE:		archival.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"fmt"
+
+	namespacepb "go.temporal.io/temporal-proto/namespace"
+)
+
+// validateArchivalConfig checks that a namespace register/update request's
+// archival URIs are only set when their corresponding archival status is
+// namespacepb.ArchivalStatus_Enabled -- a URI paired with Default or
+// Disabled is either silently ignored by the server or a sign the caller
+// meant to also flip the status, so RegisterNamespace/UpdateNamespace
+// reject it outright rather than letting it through to be resolved
+// however the server's own Default→cluster-config fallback sees fit.
+//
+// params:
+//	- historyStatus namespacepb.ArchivalStatus -> the requested
+//	HistoryArchivalStatus.
+//	- historyURI string -> the requested HistoryArchivalURI.
+//	- visibilityStatus namespacepb.ArchivalStatus -> the requested
+//	VisibilityArchivalStatus.
+//	- visibilityURI string -> the requested VisibilityArchivalURI.
+//
+// returns error -> a descriptive error if either URI is set without its
+// status being Enabled, nil otherwise.
+func validateArchivalConfig(
+	historyStatus namespacepb.ArchivalStatus,
+	historyURI string,
+	visibilityStatus namespacepb.ArchivalStatus,
+	visibilityURI string,
+) error {
+	if historyURI != "" && historyStatus != namespacepb.ArchivalStatus_Enabled {
+		return fmt.Errorf("HistoryArchivalURI %q requires HistoryArchivalStatus Enabled, got %s", historyURI, historyStatus)
+	}
+
+	if visibilityURI != "" && visibilityStatus != namespacepb.ArchivalStatus_Enabled {
+		return fmt.Errorf("VisibilityArchivalURI %q requires VisibilityArchivalStatus Enabled, got %s", visibilityURI, visibilityStatus)
+	}
+
+	return nil
+}
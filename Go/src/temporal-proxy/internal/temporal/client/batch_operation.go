@@ -0,0 +1,407 @@
+//-----------------------------------------------------------------------------
+// FILE:		batch_operation.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	commonpb "go.temporal.io/temporal-proto/common"
+	workflowpb "go.temporal.io/temporal-proto/workflow"
+	"go.temporal.io/temporal-proto/workflowservice"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// _defaultBatchOperationParallelism is how many workflow executions
+// BatchOperation drives its action against concurrently when the caller
+// doesn't set BatchOperationOptions.Parallelism.
+const _defaultBatchOperationParallelism = 10
+
+type (
+
+	// BatchActionType selects which per-workflow ClientHelper method
+	// BatchOperation applies to every workflow execution matched by its
+	// visibility query.
+	BatchActionType string
+
+	// BatchOperationOptions configures a BatchOperation run: the
+	// visibility query selecting which workflow executions to act on,
+	// which action to apply to each, and how many to run concurrently.
+	BatchOperationOptions struct {
+
+		// Namespace is the namespace to list and act on workflows in.
+		Namespace string
+
+		// Query is the SQL-like visibility query selecting the
+		// workflow executions to act on.
+		Query string
+
+		// Action is the per-workflow action to apply to every
+		// execution matched by Query.
+		Action BatchActionType
+
+		// Reason is recorded as the reason for a BatchActionTerminate
+		// action.
+		Reason string
+
+		// Details are the termination details applied by a
+		// BatchActionTerminate action.
+		Details []byte
+
+		// SignalName is the signal delivered by a BatchActionSignal
+		// action.
+		SignalName string
+
+		// SignalArgs are the signal arguments delivered by a
+		// BatchActionSignal action.
+		SignalArgs []byte
+
+		// Parallelism caps how many workflow executions BatchOperation
+		// acts on concurrently. Defaults to
+		// _defaultBatchOperationParallelism when <= 0.
+		Parallelism int
+	}
+
+	// BatchOperationResult records the outcome of applying a
+	// BatchOperation's action to one workflow execution.
+	BatchOperationResult struct {
+		WorkflowID string
+		RunID      string
+		Err        error
+	}
+)
+
+const (
+
+	// BatchActionSignal signals every matched workflow execution with
+	// BatchOperationOptions.SignalName/SignalArgs.
+	BatchActionSignal BatchActionType = "signal"
+
+	// BatchActionTerminate terminates every matched workflow execution.
+	BatchActionTerminate BatchActionType = "terminate"
+
+	// BatchActionCancel requests cancellation of every matched
+	// workflow execution.
+	BatchActionCancel BatchActionType = "cancel"
+)
+
+// BatchOperation lists every workflow execution matched by opts.Query in
+// opts.Namespace and applies opts.Action to each one concurrently,
+// bounded by opts.Parallelism, rather than relying on a server-side
+// Temporal batch job the way BatchTerminate/BatchCancel/BatchSignal/
+// BatchReset do. This runs synchronously from the proxy and reports
+// every workflow's individual outcome back to the caller directly,
+// instead of a job id to poll with DescribeBatchJob.
+//
+// params:
+//	- ctx context.Context -> the context to use to list and act on the
+//	matched workflows.
+// 	- opts BatchOperationOptions -> the query, action, and concurrency
+//	to run with.
+//
+// returns:
+//	- []BatchOperationResult -> the per-workflow outcome of applying
+//	opts.Action, one entry per execution matched by opts.Query.
+// 	- error -> a non-nil error only if opts.Query itself could not be
+//	listed; per-workflow failures are reported in each result instead.
+func (helper *ClientHelper) BatchOperation(ctx context.Context, opts BatchOperationOptions) ([]BatchOperationResult, error) {
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = _defaultBatchOperationParallelism
+	}
+
+	executions, err := helper.listAllWorkflowExecutions(ctx, opts.Namespace, opts.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchOperationResult, len(executions))
+	sem := make(chan struct{}, parallelism)
+
+	var wg sync.WaitGroup
+	for i, execution := range executions {
+		workflowID := execution.GetExecution().GetWorkflowId()
+		runID := execution.GetExecution().GetRunId()
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, workflowID string, runID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i] = BatchOperationResult{
+				WorkflowID: workflowID,
+				RunID:      runID,
+				Err:        helper.applyBatchAction(ctx, opts, workflowID, runID),
+			}
+		}(i, workflowID, runID)
+	}
+	wg.Wait()
+
+	helper.Logger.Info("Batch Operation Completed",
+		zap.String("Action", string(opts.Action)),
+		zap.String("Query", opts.Query),
+		zap.Int("WorkflowCount", len(executions)))
+
+	return results, nil
+}
+
+// applyBatchAction applies opts.Action to one workflow execution,
+// routing to the same per-workflow ClientHelper method a single-workflow
+// caller would use directly.
+func (helper *ClientHelper) applyBatchAction(ctx context.Context, opts BatchOperationOptions, workflowID string, runID string) error {
+	switch opts.Action {
+	case BatchActionTerminate:
+		return helper.TerminateWorkflow(ctx, workflowID, runID, opts.Namespace, opts.Reason, opts.Details)
+	case BatchActionCancel:
+		return helper.CancelWorkflow(ctx, workflowID, runID, opts.Namespace)
+	case BatchActionSignal:
+		return helper.SignalWorkflow(ctx, workflowID, runID, opts.Namespace, opts.SignalName, opts.SignalArgs)
+	default:
+		return fmt.Errorf("batch operation: unrecognized action %q", opts.Action)
+	}
+}
+
+// listAllWorkflowExecutions pages through ListWorkflow with query until
+// every matching workflow execution in namespace has been collected,
+// following each response's NextPageToken until the server stops
+// returning one.
+func (helper *ClientHelper) listAllWorkflowExecutions(ctx context.Context, namespace string, query string) ([]*workflowpb.WorkflowExecutionInfo, error) {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var executions []*workflowpb.WorkflowExecutionInfo
+	var pageToken []byte
+	for {
+		resp, err := workflowClient.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     namespace,
+			Query:         query,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		executions = append(executions, resp.GetExecutions()...)
+		pageToken = resp.GetNextPageToken()
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	return executions, nil
+}
+
+// ListOpenWorkflow lists the open workflow executions in a namespace
+// matching request's filters.
+//
+// params:
+//	- ctx context.Context -> the context to use to list the workflows.
+// 	- namespace string -> the namespace to list open workflows in.
+// 	- request *workflowservice.ListOpenWorkflowExecutionsRequest -> the
+//	filters and paging token for the list request.
+//
+// returns:
+//	- *workflowservice.ListOpenWorkflowExecutionsResponse -> the
+//	matching open workflow executions.
+// 	- error -> error if one is thrown, nil if the method executed with
+//	no errors.
+func (helper *ClientHelper) ListOpenWorkflow(
+	ctx context.Context,
+	namespace string,
+	request *workflowservice.ListOpenWorkflowExecutionsRequest,
+) (*workflowservice.ListOpenWorkflowExecutionsResponse, error) {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Namespace = namespace
+
+	return workflowClient.ListOpenWorkflow(ctx, request)
+}
+
+// ListClosedWorkflow lists the closed workflow executions in a namespace
+// matching request's filters.
+//
+// params:
+//	- ctx context.Context -> the context to use to list the workflows.
+// 	- namespace string -> the namespace to list closed workflows in.
+// 	- request *workflowservice.ListClosedWorkflowExecutionsRequest ->
+//	the filters and paging token for the list request.
+//
+// returns:
+//	- *workflowservice.ListClosedWorkflowExecutionsResponse -> the
+//	matching closed workflow executions.
+// 	- error -> error if one is thrown, nil if the method executed with
+//	no errors.
+func (helper *ClientHelper) ListClosedWorkflow(
+	ctx context.Context,
+	namespace string,
+	request *workflowservice.ListClosedWorkflowExecutionsRequest,
+) (*workflowservice.ListClosedWorkflowExecutionsResponse, error) {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Namespace = namespace
+
+	return workflowClient.ListClosedWorkflow(ctx, request)
+}
+
+// ListWorkflow lists the workflow executions in a namespace matching a
+// SQL-like visibility query, one page per call.
+//
+// params:
+//	- ctx context.Context -> the context to use to list the workflows.
+// 	- namespace string -> the namespace to list workflows in.
+// 	- query string -> the SQL-like visibility query selecting the
+//	workflows.
+// 	- pageToken []byte -> the paging token from a previous call, or nil
+//	to list the first page.
+//
+// returns:
+//	- *workflowservice.ListWorkflowExecutionsResponse -> the matching
+//	workflow executions and the next page's token, if any.
+// 	- error -> error if one is thrown, nil if the method executed with
+//	no errors.
+func (helper *ClientHelper) ListWorkflow(
+	ctx context.Context,
+	namespace string,
+	query string,
+	pageToken []byte,
+) (*workflowservice.ListWorkflowExecutionsResponse, error) {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return workflowClient.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace:     namespace,
+		Query:         query,
+		NextPageToken: pageToken,
+	})
+}
+
+// ScanWorkflow lists the workflow executions in a namespace matching a
+// SQL-like visibility query, the same way ListWorkflow does, but via the
+// server's scan API -- intended for queries expected to match a very
+// large number of executions, where ListWorkflow's consistency
+// guarantees aren't needed.
+//
+// params:
+//	- ctx context.Context -> the context to use to scan the workflows.
+// 	- namespace string -> the namespace to scan workflows in.
+// 	- query string -> the SQL-like visibility query selecting the
+//	workflows.
+// 	- pageToken []byte -> the paging token from a previous call, or nil
+//	to scan the first page.
+//
+// returns:
+//	- *workflowservice.ScanWorkflowExecutionsResponse -> the matching
+//	workflow executions and the next page's token, if any.
+// 	- error -> error if one is thrown, nil if the method executed with
+//	no errors.
+func (helper *ClientHelper) ScanWorkflow(
+	ctx context.Context,
+	namespace string,
+	query string,
+	pageToken []byte,
+) (*workflowservice.ScanWorkflowExecutionsResponse, error) {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return workflowClient.ScanWorkflow(ctx, &workflowservice.ScanWorkflowExecutionsRequest{
+		Namespace:     namespace,
+		Query:         query,
+		NextPageToken: pageToken,
+	})
+}
+
+// GetSearchAttributes gets the search attributes registered on the
+// Temporal server, so a caller can validate a visibility query before
+// handing it to ListWorkflow/ScanWorkflow/CountWorkflow.
+//
+// param ctx context.Context -> the context to use to get the search
+// attributes.
+//
+// returns:
+//	- *workflowservice.GetSearchAttributesResponse -> the registered
+//	search attributes and their types.
+// 	- error -> error if one is thrown, nil if the method executed with
+//	no errors.
+func (helper *ClientHelper) GetSearchAttributes(ctx context.Context) (*workflowservice.GetSearchAttributesResponse, error) {
+	return helper.serviceClient.GetSearchAttributes(ctx, &workflowservice.GetSearchAttributesRequest{})
+}
+
+// ResetWorkflowExecution resets a workflow execution to a prior point in
+// its history, so it resumes execution from that workflow task instead
+// of where it left off -- e.g. to recover from a since-fixed
+// nondeterminism bug or a bad external input.
+//
+// params:
+//	- ctx context.Context -> the context to use to reset the workflow.
+// 	- workflowID string -> the workflowID of the workflow to reset.
+// 	- runID string -> the runID of the workflow to reset.
+// 	- namespace string -> the namespace the workflow is executing on.
+// 	- reason string -> the reason recorded for the reset.
+// 	- workflowTaskFinishEventID int64 -> the event id of the
+//	WorkflowTaskCompleted (or equivalent) event to reset to.
+// 	- opts ...grpc.CallOption -> optional grpc.CallOption.
+//
+// returns:
+//	- string -> the RunID of the new workflow execution created by the
+//	reset.
+// 	- error -> error if one is thrown, nil if the method executed with
+//	no errors.
+func (helper *ClientHelper) ResetWorkflowExecution(
+	ctx context.Context,
+	workflowID string,
+	runID string,
+	namespace string,
+	reason string,
+	workflowTaskFinishEventID int64,
+	opts ...grpc.CallOption,
+) (string, error) {
+	resp, err := helper.serviceClient.ResetWorkflowExecution(ctx, &workflowservice.ResetWorkflowExecutionRequest{
+		Namespace: namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		Reason:                    reason,
+		WorkflowTaskFinishEventId: workflowTaskFinishEventID,
+	}, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	helper.Logger.Info("Workflow Reset",
+		zap.String("WorkflowID", workflowID),
+		zap.String("RunID", runID),
+		zap.String("NewRunID", resp.GetRunId()))
+
+	return resp.GetRunId(), nil
+}
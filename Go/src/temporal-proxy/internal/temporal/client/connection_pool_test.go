@@ -0,0 +1,141 @@
+//-----------------------------------------------------------------------------
+// FILE:		connection_pool_test.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.temporal.io/temporal/client"
+)
+
+// fakePoolClient is a client.Client stand-in that only tracks whether it was
+// closed -- every other method panics if called, since the connection pool
+// under test never invokes them.
+type fakePoolClient struct {
+	client.Client
+	closed int32
+}
+
+func (f *fakePoolClient) Close() {
+	atomic.StoreInt32(&f.closed, 1)
+}
+
+func (f *fakePoolClient) isClosed() bool {
+	return atomic.LoadInt32(&f.closed) == 1
+}
+
+func TestWorkflowClientsMapAddGetRemove(t *testing.T) {
+	wcm := NewWorkflowClientsMap()
+	fc := &fakePoolClient{}
+
+	wcm.Add("ns1", fc)
+	if got := wcm.Get("ns1"); got != fc {
+		t.Fatalf("expected Get to return the added client, got %v", got)
+	}
+
+	wcm.Remove("ns1")
+	if got := wcm.Get("ns1"); got != nil {
+		t.Fatalf("expected Get to return nil after Remove, got %v", got)
+	}
+	if !fc.isClosed() {
+		t.Fatal("expected Remove to close the evicted client")
+	}
+}
+
+func TestWorkflowClientsMapEvictsIdle(t *testing.T) {
+	wcm := NewWorkflowClientsMap()
+	wcm.SetLimits(128, 10*time.Millisecond)
+
+	idle := &fakePoolClient{}
+	wcm.Add("idle", idle)
+
+	time.Sleep(20 * time.Millisecond)
+
+	// Add triggers evictLocked, which should find "idle" past its idleTTL.
+	wcm.Add("fresh", &fakePoolClient{})
+
+	if got := wcm.Get("idle"); got != nil {
+		t.Fatal("expected the idle entry to have been evicted")
+	}
+	if !idle.isClosed() {
+		t.Fatal("expected the idle entry's client to have been closed on eviction")
+	}
+}
+
+func TestWorkflowClientsMapEvictsLeastRecentlyUsedOverCapacity(t *testing.T) {
+	wcm := NewWorkflowClientsMap()
+	wcm.SetLimits(2, 0)
+
+	ns1, ns2 := &fakePoolClient{}, &fakePoolClient{}
+	wcm.Add("ns1", ns1)
+	time.Sleep(time.Millisecond)
+	wcm.Add("ns2", ns2)
+	time.Sleep(time.Millisecond)
+
+	// Touch ns1 so ns2 becomes the least-recently-used entry.
+	wcm.Get("ns1")
+	time.Sleep(time.Millisecond)
+
+	wcm.Add("ns3", &fakePoolClient{})
+
+	if wcm.Get("ns2") != nil {
+		t.Fatal("expected ns2, the least-recently-used entry, to have been evicted")
+	}
+	if !ns2.isClosed() {
+		t.Fatal("expected the evicted entry's client to have been closed")
+	}
+	if wcm.Get("ns1") == nil {
+		t.Fatal("expected ns1 to still be pooled")
+	}
+	if wcm.Get("ns3") == nil {
+		t.Fatal("expected ns3 to still be pooled")
+	}
+}
+
+// TestWorkflowClientsMapConcurrentAccess drives Add/Get/Remove from many
+// goroutines at once, the access pattern GetOrCreateWorkflowClient's
+// concurrent callers put the pool under in production. Run with -race.
+func TestWorkflowClientsMapConcurrentAccess(t *testing.T) {
+	wcm := NewWorkflowClientsMap()
+	wcm.SetLimits(8, 0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			namespace := fmt.Sprintf("ns%d", i%4)
+			for j := 0; j < 50; j++ {
+				wcm.Add(namespace, &fakePoolClient{})
+				wcm.Get(namespace)
+				wcm.NeedsValidation(namespace, time.Millisecond)
+				wcm.Touch(namespace)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	wcm.CloseAll()
+	if len(wcm.Namespaces()) != 0 {
+		t.Fatal("expected CloseAll to empty the pool")
+	}
+}
@@ -0,0 +1,71 @@
+//-----------------------------------------------------------------------------
+// FILE:		headers.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type trailerCaptureKey struct{}
+
+// WithTrailerCapture returns a copy of ctx that the trailerCaptureInterceptor
+// installed on this ClientHelper's Temporal client connection will populate
+// with the server's response trailer metadata once the call made with the
+// returned context completes.
+//
+// params:
+//	- ctx context.Context -> the context the Temporal client call will be made with.
+//
+// returns:
+//	- context.Context -> ctx, carrying the trailer capture target.
+// 	- *metadata.MD -> filled in with the server's response trailer once the call completes.
+func WithTrailerCapture(ctx context.Context) (context.Context, *metadata.MD) {
+	trailer := metadata.MD{}
+
+	return context.WithValue(ctx, trailerCaptureKey{}, &trailer), &trailer
+}
+
+// trailerCaptureInterceptor is a grpc.UnaryClientInterceptor installed on
+// every Temporal client connection a ClientHelper builds. When the outgoing
+// call's context carries a trailer capture target (see WithTrailerCapture),
+// the server's response trailer is copied into it, letting handlers surface
+// it back to the caller via ProxyReply.Headers.
+func trailerCaptureInterceptor(
+	ctx context.Context,
+	method string,
+	req interface{},
+	reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	target, ok := ctx.Value(trailerCaptureKey{}).(*metadata.MD)
+	if !ok {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	var trailer metadata.MD
+	opts = append(opts, grpc.Trailer(&trailer))
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	*target = trailer
+
+	return err
+}
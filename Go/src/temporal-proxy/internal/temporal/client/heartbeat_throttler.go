@@ -0,0 +1,370 @@
+//-----------------------------------------------------------------------------
+// FILE:		heartbeat_throttler.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+
+	// _heartbeatIntervalFraction is the fraction of an activity's
+	// HeartbeatTimeout a HeartbeatThrottler waits between flushes, matching
+	// the Temporal Go SDK's own internal heartbeat throttler.
+	_heartbeatIntervalFraction = 0.8
+
+	// _heartbeatCanceledErrorStr and _heartbeatNotFoundErrorStr are matched
+	// against a failed flush's error text to detect the activity has been
+	// canceled or is no longer known to the server, the same way
+	// _namespaceNotExistErrorStr is matched elsewhere in this package --
+	// the serviceerror types themselves aren't vendored in this tree.
+	_heartbeatCanceledErrorStr = "CanceledError"
+	_heartbeatNotFoundErrorStr = "NotFoundError"
+)
+
+type (
+
+	// HeartbeatThrottlerMetrics is a point-in-time snapshot of one
+	// heartbeater's flush counters, returned by
+	// ClientHelper.HeartbeatMetrics and HeartbeatMetricsByID.
+	HeartbeatThrottlerMetrics struct {
+
+		// Flushes counts the heartbeats actually sent to the server.
+		Flushes int64
+
+		// Skipped counts the ticks on which no new details had arrived
+		// since the last flush, so nothing was sent.
+		Skipped int64
+
+		// Errors counts the flushes that failed, whether or not the
+		// failure was a cancellation.
+		Errors int64
+	}
+
+	// heartbeatState is the per-activity bookkeeping a HeartbeatThrottler
+	// keeps for one activity's heartbeater goroutine.
+	heartbeatState struct {
+		mu      sync.Mutex
+		details []interface{}
+		dirty   bool
+
+		flush  func(details []interface{}) error
+		cancel context.CancelFunc
+		done   chan struct{}
+
+		flushes int64
+		skipped int64
+		errors  int64
+	}
+
+	// HeartbeatThrottler coalesces repeated heartbeats for many
+	// concurrently-running activities, flushing each activity's latest
+	// heartbeat details to the server at most once per interval instead
+	// of once per call -- the same coalescing the Temporal Go SDK applies
+	// internally, reimplemented here because ClientHelper relays
+	// RecordActivityHeartbeat calls from a polyglot worker rather than
+	// driving an SDK-managed activity execution itself.
+	HeartbeatThrottler struct {
+		mu     sync.Mutex
+		states map[string]*heartbeatState
+	}
+)
+
+// NewHeartbeatThrottler is the default constructor for a HeartbeatThrottler.
+//
+// returns *HeartbeatThrottler -> a pointer to a new, empty HeartbeatThrottler.
+func NewHeartbeatThrottler() *HeartbeatThrottler {
+	return &HeartbeatThrottler{
+		states: make(map[string]*heartbeatState),
+	}
+}
+
+// heartbeatKey identifies one in-flight activity's heartbeater, scoped to
+// namespace plus either its task token or its workflowID/runID/activityID
+// triple, whichever the activity was identified by.
+func heartbeatKey(namespace string, id string) string {
+	return namespace + "\x00" + id
+}
+
+// start registers a heartbeater under key, canceling and replacing any
+// heartbeater already registered under it, and launches its flush
+// goroutine under ctx. This method is thread-safe.
+//
+// params:
+//	- ctx context.Context -> the go context the flush goroutine runs
+//	under; canceling it stops the heartbeater without a final flush.
+// 	- key string -> the heartbeatKey identifying the activity.
+// 	- interval time.Duration -> how often to flush dirty details.
+// 	- flush func(details []interface{}) error -> flushes details to the
+// 	server; called from the flush goroutine and from stop.
+// 	- details []interface{} -> the initial heartbeat details.
+//
+// returns context.Context -> a context derived from ctx that is canceled
+// once a flush reports the activity has been canceled or is no longer
+// known to the server, for the caller to select on from the activity body.
+func (throttler *HeartbeatThrottler) start(
+	ctx context.Context,
+	key string,
+	interval time.Duration,
+	flush func(details []interface{}) error,
+	details []interface{},
+) context.Context {
+	cancelCtx, cancel := context.WithCancel(ctx)
+
+	state := &heartbeatState{
+		details: details,
+		dirty:   true,
+		flush:   flush,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+
+	throttler.mu.Lock()
+	if old, ok := throttler.states[key]; ok {
+		old.cancel()
+	}
+	throttler.states[key] = state
+	throttler.mu.Unlock()
+
+	go state.run(cancelCtx, interval)
+
+	return cancelCtx
+}
+
+// run flushes state's latest details at most once per interval until ctx is
+// canceled, stopping the background goroutine start launched.
+func (state *heartbeatState) run(ctx context.Context, interval time.Duration) {
+	defer close(state.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			state.tryFlush()
+		}
+	}
+}
+
+// tryFlush flushes state's latest details if they've changed since the last
+// flush, canceling state if the flush reports the activity was canceled or
+// no longer exists. This method is thread-safe.
+func (state *heartbeatState) tryFlush() {
+	state.mu.Lock()
+	if !state.dirty {
+		atomic.AddInt64(&state.skipped, 1)
+		state.mu.Unlock()
+		return
+	}
+	details := state.details
+	state.dirty = false
+	state.mu.Unlock()
+
+	if err := state.flush(details); err != nil {
+		atomic.AddInt64(&state.errors, 1)
+		if strings.Contains(err.Error(), _heartbeatCanceledErrorStr) ||
+			strings.Contains(err.Error(), _heartbeatNotFoundErrorStr) {
+			state.cancel()
+		}
+		return
+	}
+
+	atomic.AddInt64(&state.flushes, 1)
+}
+
+// record updates key's latest heartbeat details, to be sent on the next
+// flush interval, replacing whatever details were recorded since the last
+// flush. It is a no-op if no heartbeater is registered under key. This
+// method is thread-safe.
+//
+// returns bool -> true if a heartbeater was registered under key, false if
+// there was nothing to record the details against.
+func (throttler *HeartbeatThrottler) record(key string, details []interface{}) bool {
+	throttler.mu.Lock()
+	state, ok := throttler.states[key]
+	throttler.mu.Unlock()
+
+	if !ok {
+		return false
+	}
+
+	state.mu.Lock()
+	state.details = details
+	state.dirty = true
+	state.mu.Unlock()
+
+	return true
+}
+
+// stop deregisters key's heartbeater and flushes its latest details
+// synchronously, for a clean handoff to CompleteActivity/
+// CompleteActivityByID. It is a no-op if no heartbeater is registered under
+// key. This method is thread-safe.
+func (throttler *HeartbeatThrottler) stop(key string) {
+	throttler.mu.Lock()
+	state, ok := throttler.states[key]
+	if ok {
+		delete(throttler.states, key)
+	}
+	throttler.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	state.cancel()
+	<-state.done
+	state.tryFlush()
+}
+
+// metrics returns a snapshot of key's flush counters, or nil if no
+// heartbeater is registered under key. This method is thread-safe.
+func (throttler *HeartbeatThrottler) metrics(key string) *HeartbeatThrottlerMetrics {
+	throttler.mu.Lock()
+	state, ok := throttler.states[key]
+	throttler.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return &HeartbeatThrottlerMetrics{
+		Flushes: atomic.LoadInt64(&state.flushes),
+		Skipped: atomic.LoadInt64(&state.skipped),
+		Errors:  atomic.LoadInt64(&state.errors),
+	}
+}
+
+// StartHeartbeater starts a managed heartbeat stream for the activity
+// identified by taskToken, flushing at most once per interval --
+// _heartbeatIntervalFraction of heartbeatTimeout -- instead of performing a
+// synchronous RecordActivityHeartbeat RPC on every call. Once started,
+// RecordActivityHeartbeat calls made with the same taskToken record their
+// details against this heartbeater instead of issuing an RPC of their own;
+// CompleteActivity flushes and tears it down automatically.
+//
+// NOTE: HeartbeatTimeout is optional on workflow.ActivityOptions and
+// commonly left at its zero value; heartbeatTimeout <= 0 has no meaningful
+// flush cadence to derive, so this is a no-op and ctx is returned unchanged
+// -- RecordActivityHeartbeat falls back to its synchronous RPC path, since
+// no heartbeater ends up registered under this activity's key.
+//
+// params:
+//	- ctx context.Context -> the go context the heartbeater's background
+//	goroutine runs under; canceling it stops the heartbeater without a
+//	final flush.
+// 	- namespace string -> the namespace the activity is running in.
+// 	- taskToken []byte -> the task token identifying the activity.
+// 	- heartbeatTimeout time.Duration -> the activity's HeartbeatTimeout,
+// 	used to derive the flush interval.
+// 	- details ...interface{} -> the initial heartbeat details.
+//
+// returns context.Context -> a context that is canceled once the server
+// reports the activity has been canceled or is no longer known to it, for
+// the caller to select on from within the activity body.
+func (helper *ClientHelper) StartHeartbeater(
+	ctx context.Context,
+	namespace string,
+	taskToken []byte,
+	heartbeatTimeout time.Duration,
+	details ...interface{},
+) context.Context {
+	if heartbeatTimeout <= 0 {
+		return ctx
+	}
+
+	key := heartbeatKey(namespace, string(taskToken))
+	interval := time.Duration(float64(heartbeatTimeout) * _heartbeatIntervalFraction)
+
+	return helper.Heartbeats.start(ctx, key, interval, func(details []interface{}) error {
+		workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+		if err != nil {
+			return err
+		}
+
+		return workflowClient.RecordActivityHeartbeat(ctx, taskToken, details)
+	}, details)
+}
+
+// StartHeartbeaterByID is StartHeartbeater for an activity identified by its
+// workflowID/runID/activityID triple rather than a task token, for use
+// alongside RecordActivityHeartbeatByID and CompleteActivityByID.
+//
+// NOTE: like StartHeartbeater, heartbeatTimeout <= 0 has no meaningful flush
+// cadence to derive, so this is a no-op and ctx is returned unchanged.
+//
+// params:
+//	- ctx context.Context -> the go context the heartbeater's background
+//	goroutine runs under; canceling it stops the heartbeater without a
+//	final flush.
+// 	- namespace string -> the namespace the activity is running in.
+// 	- workflowID string -> the workflowID of the running workflow.
+// 	- runID string -> the runID of the running temporal workflow.
+// 	- activityID string -> the activityID of the executing activity.
+// 	- heartbeatTimeout time.Duration -> the activity's HeartbeatTimeout,
+// 	used to derive the flush interval.
+// 	- details ...interface{} -> the initial heartbeat details.
+//
+// returns context.Context -> a context that is canceled once the server
+// reports the activity has been canceled or is no longer known to it, for
+// the caller to select on from within the activity body.
+func (helper *ClientHelper) StartHeartbeaterByID(
+	ctx context.Context,
+	namespace string,
+	workflowID string,
+	runID string,
+	activityID string,
+	heartbeatTimeout time.Duration,
+	details ...interface{},
+) context.Context {
+	if heartbeatTimeout <= 0 {
+		return ctx
+	}
+
+	key := heartbeatKey(namespace, workflowID+"\x00"+runID+"\x00"+activityID)
+	interval := time.Duration(float64(heartbeatTimeout) * _heartbeatIntervalFraction)
+
+	return helper.Heartbeats.start(ctx, key, interval, func(details []interface{}) error {
+		workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+		if err != nil {
+			return err
+		}
+
+		return workflowClient.RecordActivityHeartbeatByID(ctx, namespace, workflowID, runID, activityID, details)
+	}, details)
+}
+
+// HeartbeatMetrics returns a snapshot of the running heartbeater's flush
+// counters for the activity identified by taskToken, or nil if no
+// heartbeater is running for it.
+func (helper *ClientHelper) HeartbeatMetrics(namespace string, taskToken []byte) *HeartbeatThrottlerMetrics {
+	return helper.Heartbeats.metrics(heartbeatKey(namespace, string(taskToken)))
+}
+
+// HeartbeatMetricsByID is HeartbeatMetrics for an activity identified by its
+// workflowID/runID/activityID triple rather than a task token.
+func (helper *ClientHelper) HeartbeatMetricsByID(namespace string, workflowID string, runID string, activityID string) *HeartbeatThrottlerMetrics {
+	return helper.Heartbeats.metrics(heartbeatKey(namespace, workflowID+"\x00"+runID+"\x00"+activityID))
+}
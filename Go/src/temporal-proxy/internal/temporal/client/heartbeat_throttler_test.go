@@ -0,0 +1,139 @@
+//-----------------------------------------------------------------------------
+// FILE:		heartbeat_throttler_test.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestHeartbeatThrottlerCoalescesFlushes(t *testing.T) {
+	throttler := NewHeartbeatThrottler()
+
+	var flushes int32
+	ctx := throttler.start(context.Background(), "key", 5*time.Millisecond, func(details []interface{}) error {
+		atomic.AddInt32(&flushes, 1)
+		return nil
+	}, []interface{}{"initial"})
+	defer throttler.stop("key")
+
+	// record many times within a single flush interval -- only the latest
+	// details should go out on the next tick, not one flush per record.
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			throttler.record("key", []interface{}{i})
+		}(i)
+	}
+	wg.Wait()
+
+	time.Sleep(30 * time.Millisecond)
+
+	if atomic.LoadInt32(&flushes) >= 50 {
+		t.Fatalf("expected flushes to be coalesced well below the number of records, got %d", flushes)
+	}
+	if atomic.LoadInt32(&flushes) == 0 {
+		t.Fatal("expected at least one flush to have gone out")
+	}
+
+	select {
+	case <-ctx.Done():
+		t.Fatal("expected ctx to still be live, flush never returned an error")
+	default:
+	}
+}
+
+func TestHeartbeatThrottlerCancelsOnCanceledError(t *testing.T) {
+	throttler := NewHeartbeatThrottler()
+
+	ctx := throttler.start(context.Background(), "key", 5*time.Millisecond, func(details []interface{}) error {
+		return errors.New("CanceledError: activity was canceled")
+	}, []interface{}{"initial"})
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(200 * time.Millisecond):
+		t.Fatal("expected ctx to be canceled once a flush reported CanceledError")
+	}
+}
+
+func TestHeartbeatThrottlerRecordNoopWithoutRegisteredKey(t *testing.T) {
+	throttler := NewHeartbeatThrottler()
+
+	if throttler.record("unregistered", []interface{}{"x"}) {
+		t.Fatal("expected record to report false for a key with no heartbeater")
+	}
+}
+
+func TestHeartbeatThrottlerStopFlushesFinalDetails(t *testing.T) {
+	throttler := NewHeartbeatThrottler()
+
+	var lastDetails []interface{}
+	throttler.start(context.Background(), "key", time.Hour, func(details []interface{}) error {
+		lastDetails = details
+		return nil
+	}, []interface{}{"initial"})
+
+	throttler.record("key", []interface{}{"final"})
+	throttler.stop("key")
+
+	if len(lastDetails) != 1 || lastDetails[0] != "final" {
+		t.Fatalf("expected stop to flush the latest recorded details, got %v", lastDetails)
+	}
+}
+
+// TestStartHeartbeaterNoopOnNonPositiveTimeout guards against the panic
+// time.NewTicker(0) would otherwise raise: HeartbeatTimeout is optional and
+// commonly left at its zero value.
+func TestStartHeartbeaterNoopOnNonPositiveTimeout(t *testing.T) {
+	helper := &ClientHelper{
+		Logger:     zap.NewNop(),
+		Heartbeats: NewHeartbeatThrottler(),
+	}
+
+	ctx := context.Background()
+	got := helper.StartHeartbeater(ctx, "namespace", []byte("token"), 0)
+	if got != ctx {
+		t.Fatal("expected StartHeartbeater to return ctx unchanged for a non-positive heartbeatTimeout")
+	}
+
+	if helper.Heartbeats.record(heartbeatKey("namespace", string([]byte("token"))), []interface{}{"x"}) {
+		t.Fatal("expected no heartbeater to have been registered")
+	}
+}
+
+func TestStartHeartbeaterByIDNoopOnNonPositiveTimeout(t *testing.T) {
+	helper := &ClientHelper{
+		Logger:     zap.NewNop(),
+		Heartbeats: NewHeartbeatThrottler(),
+	}
+
+	ctx := context.Background()
+	got := helper.StartHeartbeaterByID(ctx, "namespace", "workflowID", "runID", "activityID", -time.Second)
+	if got != ctx {
+		t.Fatal("expected StartHeartbeaterByID to return ctx unchanged for a non-positive heartbeatTimeout")
+	}
+}
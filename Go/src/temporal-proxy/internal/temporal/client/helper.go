@@ -20,10 +20,15 @@ package proxyclient
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/pborman/uuid"
+	batchpb "go.temporal.io/temporal-proto/batch"
+	enumspb "go.temporal.io/temporal-proto/enums"
+	historypb "go.temporal.io/temporal-proto/history"
 	"go.temporal.io/temporal-proto/workflowservice"
 	"go.temporal.io/temporal/client"
 	"go.temporal.io/temporal/encoded"
@@ -32,6 +37,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
+	internal "temporal-proxy/internal"
 	proxyerror "temporal-proxy/internal/temporal/error"
 )
 
@@ -41,8 +47,38 @@ const (
 	// temporal when trying to perform and operation on a namespace that does not
 	// yet exists.
 	_namespaceNotExistErrorStr = "EntityNotExistsError{Message: Namespace:"
+
+	// _namespacePollInitialInterval is the delay before
+	// NewDefaultRetryPolicy's first retry of a transient DescribeNamespace
+	// failure.
+	_namespacePollInitialInterval = time.Second
+
+	// _namespacePollBackoffCoefficient is the multiplier NewDefaultRetryPolicy
+	// and newConnectionVerificationRetryPolicy apply to their retry interval
+	// after each attempt.
+	_namespacePollBackoffCoefficient = 2.0
+
+	// _namespacePollMaximumInterval caps how long NewDefaultRetryPolicy waits
+	// between retries.
+	_namespacePollMaximumInterval = 30 * time.Second
 )
 
+// ErrNamespaceNotExist is returned by WaitForReady once DescribeNamespace
+// reports the configured namespace doesn't exist on the temporal server,
+// distinguished from a transient connection failure so a caller -- and
+// ultimately the Neon.Temporal client on the other side of the proxy --
+// can translate it into its own domain-not-found exception instead of
+// retrying a namespace that will never appear.
+var ErrNamespaceNotExist = errors.New("proxyclient: namespace does not exist")
+
+// ErrTokenNamespaceMismatch is returned by CompleteActivity,
+// CompleteActivityByID, RecordActivityHeartbeat, and
+// RecordActivityHeartbeatByID when EnforceTokenNamespace is on and the
+// call's namespace disagrees with the namespace the task token was minted
+// against -- or, for the ByID variants with no task token to check, names a
+// namespace this ClientHelper has no registered workflow client for.
+var ErrTokenNamespaceMismatch = errors.New("proxyclient: task token namespace does not match request namespace")
+
 type (
 
 	// ClientHelper holds configuration details for building
@@ -64,13 +100,74 @@ type (
 		Builder         *TemporalClientBuilder
 		NamespaceClient client.NamespaceClient
 		WorkflowClients *WorkflowClientsMap
-	}
 
-	// WorkflowClientsMap holds a thread-safe map[interface{}]interface{} of
-	// temporal WorkflowClients with their namespace.
-	WorkflowClientsMap struct {
-		sync.Mutex
-		clients map[string]client.Client
+		// Workers tracks every worker.Worker StartWorker has started for
+		// this ClientHelper, keyed by workerID, so StopWorkerGracefully,
+		// RestartWorker, ListWorkers, and WorkerHealth can act on a
+		// worker by its ID alone. See WorkerRegistry.
+		Workers *WorkerRegistry
+
+		// EnforceTokenNamespace mirrors the Temporal frontend's own
+		// EnableTokenNamespaceEnforcement dynamic config (see
+		// temporal/temporal#1086): when true, CompleteActivity and
+		// RecordActivityHeartbeat decode the namespace id embedded in
+		// their task token and reject the call with
+		// ErrTokenNamespaceMismatch if it disagrees with the namespace
+		// parameter, and their ByID counterparts reject a namespace
+		// that has no registered workflow client -- rather than
+		// silently forwarding the call to whichever namespace the
+		// caller happened to name and letting the server return an
+		// opaque InvalidArgument.
+		EnforceTokenNamespace bool
+
+		// unaryInterceptors and streamInterceptors are applied, in
+		// order, to every per-namespace client.Client
+		// GetOrCreateWorkflowClient builds, alongside the
+		// trailerCaptureInterceptor header-forwarding already wires
+		// in. See SetInterceptors.
+		unaryInterceptors  []grpc.UnaryClientInterceptor
+		streamInterceptors []grpc.StreamClientInterceptor
+
+		// RetryPolicy configures the backoff SetupServiceConfig and
+		// ExecuteWorkflow retry their respective loops with. See
+		// RetryPolicy, SetRetryPolicy.
+		RetryPolicy *RetryPolicy
+
+		// Heartbeats tracks every heartbeater StartHeartbeater/
+		// StartHeartbeaterByID has started, so RecordActivityHeartbeat/
+		// RecordActivityHeartbeatByID can coalesce into it instead of
+		// issuing an RPC per call, and CompleteActivity/
+		// CompleteActivityByID can flush and tear it down. See
+		// HeartbeatThrottler.
+		Heartbeats *HeartbeatThrottler
+
+		// namespaceOptionsMu guards namespaceOptions.
+		namespaceOptionsMu sync.RWMutex
+
+		// namespaceOptions holds the per-namespace client.Options
+		// overrides RegisterNamespaceOptions installs, layered over
+		// clientOptions by buildNamespaceClientOptions when
+		// GetOrCreateWorkflowClient builds a namespace's client.Client.
+		namespaceOptions map[string]client.Options
+
+		// CircuitBreaker tracks, per namespace, whether the Temporal
+		// server is currently reachable, so CompleteActivity,
+		// CompleteActivityByID, RecordActivityHeartbeat, and
+		// RecordActivityHeartbeatByID pause against an unreachable
+		// namespace instead of each failing their own RPC. Tripped by
+		// WaitForReady and GetOrCreateWorkflowClient's and
+		// HealthCheck's eviction paths, reset once one of them
+		// observes the namespace healthy again. See
+		// NamespaceCircuitBreaker.
+		CircuitBreaker *NamespaceCircuitBreaker
+
+		// readinessChan is closed by markReady the first time
+		// WaitForReady verifies any namespace. See Readiness.
+		readinessChan chan struct{}
+
+		// readyOnce guards readinessChan, so markReady only ever
+		// closes it once.
+		readyOnce sync.Once
 	}
 )
 
@@ -81,12 +178,25 @@ type (
 func NewClientHelper() *ClientHelper {
 	helper := new(ClientHelper)
 	helper.WorkflowClients = NewWorkflowClientsMap()
+	helper.Workers = NewWorkerRegistry()
+	helper.RetryPolicy = NewDefaultRetryPolicy()
+	helper.Heartbeats = NewHeartbeatThrottler()
+	helper.namespaceOptions = make(map[string]client.Options)
+	helper.CircuitBreaker = NewNamespaceCircuitBreaker()
+	helper.readinessChan = make(chan struct{})
 	return helper
 }
 
 //----------------------------------------------------------------------------------
 // ClientHelper instance methods
 
+// NOTE: there's no TChannel/YARPC transport to select away from here --
+// this ClientHelper already builds its workflowservice.WorkflowServiceClient
+// over the Temporal Go SDK's gRPC-only client.Options/TemporalClientBuilder
+// path (the Cadence-era TChannel outbound that predated Temporal's protobuf
+// switch). A Transport property would have nothing to switch between in
+// this tree.
+
 // SetHostPort sets the hostPort in a ClientHelper.
 //
 // param value string --> the string value to set as the hostPort.
@@ -108,10 +218,206 @@ func (helper *ClientHelper) SetClientOptions(value client.Options) {
 	helper.clientOptions = value
 }
 
+// SetInterceptors sets the gRPC unary/stream client interceptors applied
+// to every per-namespace client.Client GetOrCreateWorkflowClient builds,
+// so a caller can plug in auth headers, metrics, tracing, or a
+// header-forwarding interceptor (see trailerCaptureInterceptor) without
+// forking ClientHelper. Must be called before the first
+// GetOrCreateWorkflowClient for a given namespace -- like
+// SetClientOptions, it has no effect on a client.Client already built.
+//
+// params:
+//	- unary []grpc.UnaryClientInterceptor -> applied, in order, to every
+//	unary call a built client.Client makes.
+// 	- stream []grpc.StreamClientInterceptor -> applied, in order, to
+//	every streaming call a built client.Client makes.
+func (helper *ClientHelper) SetInterceptors(unary []grpc.UnaryClientInterceptor, stream []grpc.StreamClientInterceptor) {
+	helper.unaryInterceptors = unary
+	helper.streamInterceptors = stream
+}
+
+// SetRetryPolicy sets the RetryPolicy SetupServiceConfig and ExecuteWorkflow
+// retry their respective loops with, replacing the default
+// NewClientHelper installs. Must be called before SetupServiceConfig to
+// affect the service-client build retry; takes effect immediately for
+// ExecuteWorkflow.
+//
+// param policy *RetryPolicy -> the RetryPolicy to retry with.
+func (helper *ClientHelper) SetRetryPolicy(policy *RetryPolicy) {
+	helper.RetryPolicy = policy
+}
+
+// RegisterNamespaceOptions installs a per-namespace client.Options override
+// -- HostPort, TLS, HeaderProvider, DataConverter, interceptors, and so on
+// -- that buildNamespaceClientOptions layers over clientOptions when
+// GetOrCreateWorkflowClient builds namespace's client.Client, so rotating
+// credentials for one namespace doesn't require a process restart. Only
+// the override's non-zero fields take effect; the rest fall back to
+// clientOptions.
+//
+// If namespace already has a pooled client.Client, this also hot-reloads
+// it: a replacement is built with the new options and swapped in via
+// WorkflowClients.Swap immediately, and the superseded client.Client is
+// closed once it has quiesced -- see WorkflowClientsMap's NOTE on Swap.
+//
+// params:
+//	- namespace string -> the namespace to apply opts to.
+// 	- opts client.Options -> the per-namespace override.
+//
+// returns error -> a non-nil error if namespace was already pooled and
+// building its replacement client.Client failed; the override is installed
+// either way.
+func (helper *ClientHelper) RegisterNamespaceOptions(namespace string, opts client.Options) error {
+	helper.namespaceOptionsMu.Lock()
+	helper.namespaceOptions[namespace] = opts
+	helper.namespaceOptionsMu.Unlock()
+
+	if helper.WorkflowClients.Get(namespace) == nil {
+		return nil
+	}
+
+	newClient, err := client.NewClient(helper.buildNamespaceClientOptions(namespace, helper.extraDialOptions()...))
+	if err != nil {
+		return err
+	}
+
+	helper.WorkflowClients.Swap(namespace, newClient)
+
+	helper.Logger.Info("Hot-reloaded pooled workflow client", zap.String("Namespace", namespace))
+
+	return nil
+}
+
+// extraDialOptions returns the grpc.DialOption values every per-namespace
+// client.Client this ClientHelper builds needs layered onto
+// helper.Builder.clientOptions -- the trailer-capture interceptor (unless
+// header forwarding is disabled) plus any interceptors registered with
+// SetInterceptors -- computed fresh on every call rather than appended once
+// into helper.Builder.clientOptions.ConnectionOptions.DialOptions, which
+// would otherwise grow that shared slice by the same interceptors again on
+// every later rebuild (re-validation eviction, idle/LRU eviction, namespace
+// option hot-reload).
+func (helper *ClientHelper) extraDialOptions() []grpc.DialOption {
+	var dialOptions []grpc.DialOption
+
+	if !internal.DisableHeaderForwarding {
+		dialOptions = append(dialOptions, grpc.WithChainUnaryInterceptor(trailerCaptureInterceptor))
+	}
+
+	if len(helper.unaryInterceptors) > 0 {
+		dialOptions = append(dialOptions, grpc.WithChainUnaryInterceptor(helper.unaryInterceptors...))
+	}
+
+	if len(helper.streamInterceptors) > 0 {
+		dialOptions = append(dialOptions, grpc.WithChainStreamInterceptor(helper.streamInterceptors...))
+	}
+
+	return dialOptions
+}
+
+// buildNamespaceClientOptions returns the client.Options
+// GetOrCreateWorkflowClient and RegisterNamespaceOptions should build
+// namespace's client.Client with: helper.Builder.clientOptions, with
+// extraDialOptions and any non-zero field of namespace's registered
+// override (see RegisterNamespaceOptions) layered on top.
+func (helper *ClientHelper) buildNamespaceClientOptions(namespace string, extraDialOptions ...grpc.DialOption) client.Options {
+	opts := helper.Builder.clientOptions
+
+	// copy DialOptions into a freshly allocated slice before appending
+	// anything to it -- opts is a shallow copy of helper.Builder.clientOptions,
+	// so its ConnectionOptions.DialOptions slice field still aliases the same
+	// backing array, and appending to it in place could silently grow that
+	// shared array out from under helper.Builder.clientOptions instead of
+	// this call's own copy.
+	dialOptions := make([]grpc.DialOption, 0, len(opts.ConnectionOptions.DialOptions)+len(extraDialOptions))
+	dialOptions = append(dialOptions, opts.ConnectionOptions.DialOptions...)
+	dialOptions = append(dialOptions, extraDialOptions...)
+	opts.ConnectionOptions.DialOptions = dialOptions
+
+	helper.namespaceOptionsMu.RLock()
+	override, ok := helper.namespaceOptions[namespace]
+	helper.namespaceOptionsMu.RUnlock()
+
+	if !ok {
+		return opts
+	}
+
+	if override.HostPort != "" {
+		opts.HostPort = override.HostPort
+	}
+
+	if override.ConnectionOptions.TLS != nil {
+		opts.ConnectionOptions.TLS = override.ConnectionOptions.TLS
+	}
+
+	if override.HeaderProvider != nil {
+		opts.HeaderProvider = override.HeaderProvider
+	}
+
+	if override.DataConverter != nil {
+		opts.DataConverter = override.DataConverter
+	}
+
+	if len(override.ConnectionOptions.DialOptions) > 0 {
+		opts.ConnectionOptions.DialOptions = append(opts.ConnectionOptions.DialOptions, override.ConnectionOptions.DialOptions...)
+	}
+
+	return opts
+}
+
+// HealthCheck pings every pooled client.Client with DescribeNamespace,
+// evicting any namespace it fails against -- the same check
+// GetOrCreateWorkflowClient already performs lazily once a client is older
+// than _connectionPoolValidationInterval, exposed here so a caller can run
+// it proactively (e.g. on a timer) instead of waiting for the next call to
+// a given namespace.
+//
+// param ctx context.Context -> the go context used to ping each namespace.
+//
+// returns []string -> the namespaces evicted because DescribeNamespace
+// failed against them.
+func (helper *ClientHelper) HealthCheck(ctx context.Context) []string {
+	var evicted []string
+
+	for _, namespace := range helper.WorkflowClients.Namespaces() {
+		if _, err := helper.DescribeNamespace(ctx, namespace); err != nil {
+			helper.Logger.Warn("Evicting unhealthy pooled workflow client",
+				zap.String("Namespace", namespace), zap.Error(err))
+			helper.WorkflowClients.Remove(namespace)
+			helper.CircuitBreaker.Trip(namespace)
+			evicted = append(evicted, namespace)
+			continue
+		}
+
+		helper.WorkflowClients.Touch(namespace)
+		helper.CircuitBreaker.Reset(namespace)
+	}
+
+	return evicted
+}
+
+// CloseNamespace closes and evicts the pooled client.Client for namespace,
+// if one exists. The next GetOrCreateWorkflowClient call for namespace
+// builds a fresh one.
+//
+// param namespace string -> the namespace to tear down the pooled client for.
+func (helper *ClientHelper) CloseNamespace(namespace string) {
+	helper.WorkflowClients.Remove(namespace)
+}
+
+// CloseAll closes and evicts every pooled client.Client this ClientHelper
+// has built, for a clean teardown.
+func (helper *ClientHelper) CloseAll() {
+	helper.WorkflowClients.CloseAll()
+}
+
 // SetupServiceConfig configures a ClientHelper's workflowserviceclient.Interface
 // Service.  It also sets the Logger, the TemporalClientBuilder, and acts as a helper for
 // creating new temporal workflow and namespace clients.
 //
+// NOTE: connection verification is WaitForReady's job now, not a
+// goroutine-per-attempt channel private to this function -- see WaitForReady.
+//
 // params ctx context.Context -> go context to use to verify a connection has been established to the temporal server.
 //
 // returns error -> error if there were any problems configuring
@@ -126,20 +432,20 @@ func (helper *ClientHelper) SetupServiceConfig(ctx context.Context) error {
 	helper.Builder = NewBuilder(helper.Logger).
 		SetClientOptions(helper.clientOptions)
 
-	n := 30
 	var err error
 	var service workflowservice.WorkflowServiceClient
 
-	// build the service client
-	// retry n number of times
+	// build the service client, retrying per helper.RetryPolicy
 
-	for i := 0; i <= n; i++ {
+	for attempt := 1; ; attempt++ {
 		service, err = helper.Builder.BuildServiceClient()
-		if err != nil {
-			time.Sleep(time.Second * 1)
-			continue
+		if err == nil {
+			break
 		}
-		break
+		if !helper.RetryPolicy.ShouldRetry(attempt, err) {
+			break
+		}
+		time.Sleep(helper.RetryPolicy.NextInterval(attempt))
 	}
 
 	if err != nil {
@@ -160,16 +466,10 @@ func (helper *ClientHelper) SetupServiceConfig(ctx context.Context) error {
 
 	helper.NamespaceClient = namespaceClient
 
-	// validate that a connection has been established
-	// make a channel that waits for a connection to be established
-	// until returning ready
-
-	connectChan := make(chan error)
-	defer close(connectChan)
+	// validate that a connection has been established by waiting for the
+	// system namespace to become reachable
 
-	// poll on system namespace
-
-	err = helper.pollNamespace(ctx, connectChan, client.DefaultNamespace)
+	err = helper.WaitForReady(ctx, client.DefaultNamespace, nil)
 	if err != nil {
 		helper = nil
 		return err
@@ -212,13 +512,16 @@ func (helper *ClientHelper) SetupTemporalClients(ctx context.Context, opts clien
 
 // StartWorker starts a workflow worker and activity worker based on configured options.
 // The worker will listen for workflows registered with the same taskList.
+// The started worker is registered in helper.Workers under workerID, so it
+// can later be looked up by StopWorkerGracefully, RestartWorker, ListWorkers,
+// and WorkerHealth.
 //
 // params:
 //	- namespace string -> the namespace that identifies the client to start the worker with.
 // 	- taskList string -> the name of the group of temporal workflows for the worker to listen for.
-// 	- options worker.Options -> Options used to configure a worker instance.
 //	- workerID int64 -> the id of the new worker that will be mapped internally in
 // 	the temporal-proxy.
+// 	- options worker.Options -> Options used to configure a worker instance.
 //
 // returns:
 //	- worker.Worker -> the worker.Worker returned by the worker.New()
@@ -228,6 +531,7 @@ func (helper *ClientHelper) SetupTemporalClients(ctx context.Context, opts clien
 func (helper *ClientHelper) StartWorker(
 	namespace string,
 	taskList string,
+	workerID int64,
 	options worker.Options,
 ) (worker.Worker, error) {
 	client, err := helper.GetOrCreateWorkflowClient(namespace)
@@ -236,10 +540,12 @@ func (helper *ClientHelper) StartWorker(
 	}
 
 	worker := worker.New(client, taskList, options)
-	if worker.Start() != nil {
+	if err := worker.Start(); err != nil {
 		return nil, err
 	}
 
+	helper.Workers.add(workerID, namespace, taskList, options, worker)
+
 	return worker, nil
 }
 
@@ -281,6 +587,15 @@ func (helper *ClientHelper) DescribeNamespace(ctx context.Context, namespace str
 //
 // returns error -> error if one is thrown, nil if the method executed with no errors.
 func (helper *ClientHelper) RegisterNamespace(ctx context.Context, request *workflowservice.RegisterNamespaceRequest) error {
+	if err := validateArchivalConfig(
+		request.GetHistoryArchivalStatus(),
+		request.GetHistoryArchivalURI(),
+		request.GetVisibilityArchivalStatus(),
+		request.GetVisibilityArchivalURI(),
+	); err != nil {
+		return err
+	}
+
 	err := helper.NamespaceClient.Register(ctx, request)
 	if err != nil {
 		return err
@@ -301,6 +616,17 @@ func (helper *ClientHelper) RegisterNamespace(ctx context.Context, request *work
 //
 // returns error -> error if one is thrown, nil if the method executed with no errors.
 func (helper *ClientHelper) UpdateNamespace(ctx context.Context, request *workflowservice.UpdateNamespaceRequest) error {
+	if config := request.GetConfig(); config != nil {
+		if err := validateArchivalConfig(
+			config.GetHistoryArchivalStatus(),
+			config.GetHistoryArchivalURI(),
+			config.GetVisibilityArchivalStatus(),
+			config.GetVisibilityArchivalURI(),
+		); err != nil {
+			return err
+		}
+	}
+
 	err := helper.NamespaceClient.Update(ctx, request)
 	if err != nil {
 		return err
@@ -359,19 +685,21 @@ func (helper *ClientHelper) ExecuteWorkflow(
 	workflow interface{},
 	args ...interface{},
 ) (client.WorkflowRun, error) {
-	n := 30
 	var workflowRun client.WorkflowRun
 	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	// start the workflow, but put in a loop
-	// to check if the namespace has been detected yet
-	// by temporal server (primarily for unit testing,
-	// loop should never execute more than once in production)
-	for i := 0; i < n; i++ {
+	// start the workflow, but put in a loop to check if the namespace has
+	// been detected yet by temporal server, retrying per helper.RetryPolicy
+	// (primarily for unit testing, loop should never execute more than
+	// once in production)
+	for attempt := 1; ; attempt++ {
 		workflowRun, err = workflowClient.ExecuteWorkflow(ctx, options, workflow, args...)
 		if err != nil {
-			if (strings.Contains(err.Error(), _namespaceNotExistErrorStr)) && (i < n-1) {
-				time.Sleep(time.Second)
+			if strings.Contains(err.Error(), _namespaceNotExistErrorStr) && helper.RetryPolicy.ShouldRetry(attempt, err) {
+				time.Sleep(helper.RetryPolicy.NextInterval(attempt))
 				continue
 			}
 
@@ -420,6 +748,67 @@ func (helper *ClientHelper) GetWorkflow(
 	return workflowRun, nil
 }
 
+// GetWorkflowHistory gets a client.HistoryEventIterator over a temporal
+// workflow's execution history, paginating via GetWorkflowExecutionHistory
+// gRPC calls under the hood as the iterator is consumed, rather than
+// loading the whole history into memory up front.
+//
+// params:
+//	- ctx context.Context -> the context to use to page through history.
+// 	- workflowID string -> the workflowID of the workflow to fetch history for.
+// 	- runID string -> the runID of the workflow to fetch history for.
+// 	- namespace string -> the namespace the workflow is executing on.
+// 	- isLongPoll bool -> true to block the iterator waiting on new
+// 	history events for a still-open workflow, rather than returning only
+// 	what's been recorded so far.
+// 	- filterType enumspb.HistoryEventFilterType -> which history events
+// 	to include (e.g. only the ones needed to determine the workflow's
+// 	close status).
+//
+// returns:
+//	- client.HistoryEventIterator -> an iterator over the workflow's
+// 	history events.
+// 	- error -> an error if the workflow's client could not be resolved,
+// 	or nil otherwise.
+func (helper *ClientHelper) GetWorkflowHistory(
+	ctx context.Context,
+	workflowID string,
+	runID string,
+	namespace string,
+	isLongPoll bool,
+	filterType enumspb.HistoryEventFilterType,
+) (client.HistoryEventIterator, error) {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	iter := workflowClient.GetWorkflowHistory(ctx, workflowID, runID, isLongPoll, filterType)
+
+	helper.Logger.Info("Get Workflow History",
+		zap.String("WorkflowID", workflowID),
+		zap.String("RunID", runID))
+
+	return iter, nil
+}
+
+// ReplayWorkflowHistory feeds a serialized workflow history through the
+// temporal SDK's replayer, so a caller can diagnose a nondeterminism bug
+// (or export/inspect a workflow's execution) without needing a live
+// Temporal server to replay against.
+//
+// params:
+//	- logger *zap.Logger -> the logger the replayer reports determinism
+// 	violations and replay progress to.
+// 	- history *historypb.History -> the workflow history to replay,
+// 	typically drained from GetWorkflowHistory's iterator.
+//
+// returns error -> a non-nil error if the history could not be replayed
+// deterministically, nil otherwise.
+func (helper *ClientHelper) ReplayWorkflowHistory(logger *zap.Logger, history *historypb.History) error {
+	return worker.ReplayWorkflowHistory(logger, history)
+}
+
 // DescribeTaskList gets the description of a registered temporal namespace.
 //
 // params:
@@ -697,9 +1086,78 @@ func (helper *ClientHelper) QueryWorkflow(
 	return value, nil
 }
 
+// enforceTaskTokenNamespace decodes the namespace id embedded in taskToken
+// and confirms it matches namespace, when EnforceTokenNamespace is on --
+// mirroring the Temporal frontend's own EnableTokenNamespaceEnforcement
+// dynamic config (temporal/temporal#1086), which rejects a completion or
+// heartbeat made against a task token issued for a different namespace,
+// but checked here before any RPC is issued.
+//
+// params:
+//	- ctx context.Context -> the go context used to look up namespace's id.
+//	- taskToken []byte -> the task token to decode.
+//	- namespace string -> the namespace the caller claims to be acting on.
+//
+// returns error -> ErrTokenNamespaceMismatch, wrapped with the offending
+// ids, if the token's namespace id does not match namespace's; a non-nil
+// error if taskToken could not be decoded or namespace could not be
+// described; nil if they agree.
+func (helper *ClientHelper) enforceTaskTokenNamespace(ctx context.Context, taskToken []byte, namespace string) error {
+	tokenNamespaceID, err := decodeTaskTokenNamespaceID(taskToken)
+	if err != nil {
+		return fmt.Errorf("task token namespace enforcement: %w", err)
+	}
+
+	resp, err := helper.DescribeNamespace(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	if resp.GetNamespaceInfo().GetId() != tokenNamespaceID {
+		return fmt.Errorf("%w: task token was issued for namespace id %q, not %q",
+			ErrTokenNamespaceMismatch, tokenNamespaceID, resp.GetNamespaceInfo().GetId())
+	}
+
+	return nil
+}
+
+// enforceNamespaceRegistered confirms namespace already has a pooled
+// client.Client in helper.WorkflowClients, when EnforceTokenNamespace is on.
+// It's the lighter-weight check CompleteActivityByID and
+// RecordActivityHeartbeatByID fall back to in place of
+// enforceTaskTokenNamespace, since neither has a task token to decode a
+// namespace out of -- the workflowID/runID/activityID triple identifies the
+// activity directly.
+//
+// param namespace string -> the namespace the caller claims to be acting on.
+//
+// returns error -> ErrTokenNamespaceMismatch if namespace has no registered
+// workflow client, nil otherwise.
+func (helper *ClientHelper) enforceNamespaceRegistered(namespace string) error {
+	if helper.WorkflowClients.Get(namespace) != nil {
+		return nil
+	}
+
+	helper.Logger.Warn("Rejected activity call: namespace has no registered workflow client",
+		zap.String("Namespace", namespace))
+
+	return fmt.Errorf("%w: namespace %q has no registered workflow client", ErrTokenNamespaceMismatch, namespace)
+}
+
 // CompleteActivity externally completes the execution of an activity using a
 // task token.
 //
+// NOTE: temporalError is reconstructed into a typed
+// temporal.ApplicationError/TimeoutError/CanceledError/TerminatedError via
+// proxyerror.ToTemporalFailure before being handed to the SDK, preserving
+// its Type and Cause chain, and whichever of its Details and NonRetryable
+// flag ToTemporalFailure carries for that Type, rather than
+// flattening it into an opaque string -- so a workflow awaiting this
+// activity can still errors.As it or inspect NonRetryable().
+//
+// NOTE: blocks until namespace's circuit breaker is closed if it's open --
+// see NamespaceCircuitBreaker.
+//
 // params:
 //	- ctx context.Context -> the go context used to execute the complete activity call.
 // 	- taskToken []byte -> a task token used to complete the activity encoded as
@@ -716,14 +1174,26 @@ func (helper *ClientHelper) CompleteActivity(
 	result interface{},
 	temporalError *proxyerror.TemporalError,
 ) error {
+	if helper.EnforceTokenNamespace {
+		if err := helper.enforceTaskTokenNamespace(ctx, taskToken, namespace); err != nil {
+			return err
+		}
+	}
+
+	if err := helper.CircuitBreaker.Wait(ctx, namespace); err != nil {
+		return err
+	}
+
 	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
 	if err != nil {
 		return err
 	}
 
+	helper.Heartbeats.stop(heartbeatKey(namespace, string(taskToken)))
+
 	var e error
 	if temporalError != nil {
-		e = errors.New(temporalError.ToString())
+		e = proxyerror.ToTemporalFailure(temporalError)
 	}
 
 	err = workflowClient.CompleteActivity(ctx, taskToken, result, e)
@@ -741,6 +1211,15 @@ func (helper *ClientHelper) CompleteActivity(
 // CompleteActivityByID externally completes the execution of an activity by
 // string Id.
 //
+// NOTE: unlike CompleteActivity, this has no task token to decode a
+// namespace id out of -- the workflowID/runID/activityID triple identifies
+// the activity directly -- so when EnforceTokenNamespace is on, it instead
+// falls back to enforceNamespaceRegistered. Like CompleteActivity,
+// temporalError is reconstructed into a typed temporal.Failure via
+// proxyerror.ToTemporalFailure rather than flattened into a string -- see
+// CompleteActivity's NOTE. Likewise blocks until namespace's circuit breaker
+// is closed if it's open.
+//
 // params:
 //	- ctx context.Context -> the go context used to execute the complete activity call.
 // 	- namespace string -> the namespace the activity to complete is running on.
@@ -760,14 +1239,26 @@ func (helper *ClientHelper) CompleteActivityByID(
 	result interface{},
 	temporalError *proxyerror.TemporalError,
 ) error {
+	if helper.EnforceTokenNamespace {
+		if err := helper.enforceNamespaceRegistered(namespace); err != nil {
+			return err
+		}
+	}
+
+	if err := helper.CircuitBreaker.Wait(ctx, namespace); err != nil {
+		return err
+	}
+
 	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
 	if err != nil {
 		return err
 	}
 
+	helper.Heartbeats.stop(heartbeatKey(namespace, workflowID+"\x00"+runID+"\x00"+activityID))
+
 	var e error
 	if temporalError != nil {
-		e = errors.New(temporalError.ToString())
+		e = proxyerror.ToTemporalFailure(temporalError)
 	}
 
 	err = workflowClient.CompleteActivityByID(
@@ -792,6 +1283,11 @@ func (helper *ClientHelper) CompleteActivityByID(
 
 // RecordActivityHeartbeat records heartbeat for an activity.
 //
+// NOTE: if StartHeartbeater was called for this taskToken, this records
+// details against that heartbeater instead of issuing an RPC of its own --
+// see HeartbeatThrottler. Otherwise blocks until namespace's circuit
+// breaker is closed if it's open.
+//
 // params:
 //	- ctx context.Context -> the go context used to record a heartbeat for an activity.
 // 	- taskToken []byte -> a task token used to record a heartbeat for an activity
@@ -806,6 +1302,20 @@ func (helper *ClientHelper) RecordActivityHeartbeat(
 	namespace string,
 	details ...interface{},
 ) error {
+	if helper.EnforceTokenNamespace {
+		if err := helper.enforceTaskTokenNamespace(ctx, taskToken, namespace); err != nil {
+			return err
+		}
+	}
+
+	if helper.Heartbeats.record(heartbeatKey(namespace, string(taskToken)), details) {
+		return nil
+	}
+
+	if err := helper.CircuitBreaker.Wait(ctx, namespace); err != nil {
+		return err
+	}
+
 	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
 	if err != nil {
 		return err
@@ -824,6 +1334,14 @@ func (helper *ClientHelper) RecordActivityHeartbeat(
 // RecordActivityHeartbeatByID records heartbeat for an activity externally by
 // string Id.
 //
+// NOTE: like CompleteActivityByID, this has no task token to decode a
+// namespace id out of, so when EnforceTokenNamespace is on, it falls back to
+// enforceNamespaceRegistered. Likewise, if StartHeartbeaterByID was called
+// for this workflowID/runID/activityID, this records details against that
+// heartbeater instead of issuing an RPC of its own -- see
+// HeartbeatThrottler. Otherwise blocks until namespace's circuit breaker is
+// closed if it's open.
+//
 // params:
 //	- ctx context.Context -> the go context used to record a heartbeat for an activity.
 // 	- namespace string -> the namespace the activity to is running in.
@@ -841,6 +1359,20 @@ func (helper *ClientHelper) RecordActivityHeartbeatByID(
 	activityID string,
 	details ...interface{},
 ) error {
+	if helper.EnforceTokenNamespace {
+		if err := helper.enforceNamespaceRegistered(namespace); err != nil {
+			return err
+		}
+	}
+
+	if helper.Heartbeats.record(heartbeatKey(namespace, workflowID+"\x00"+runID+"\x00"+activityID), details) {
+		return nil
+	}
+
+	if err := helper.CircuitBreaker.Wait(ctx, namespace); err != nil {
+		return err
+	}
+
 	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
 	if err != nil {
 		return err
@@ -866,107 +1398,298 @@ func (helper *ClientHelper) RecordActivityHeartbeatByID(
 	return nil
 }
 
-// GetOrCreateWorkflowClient queries workflowClients looking for
-// a temporal WorkflowClient at a specified namespace.
+// CountWorkflow counts the workflows in a namespace matching a SQL-like
+// visibility query. This is called before kicking off a batch operation
+// so callers can log how many workflow executions will be affected.
 //
-// param namespace string -> the namespace of the temporal WorkflowClient.
+// params:
+//	- ctx context.Context -> the context to use to count the workflows.
+// 	- namespace string -> the namespace to count workflows in.
+// 	- query string -> the SQL-like visibility query (e.g.
+// 	"WorkflowType='X' AND ExecutionStatus='Running'").
 //
-// returns client.Client -> the WorkflowClient associated with
-// the specified namespace.
-func (helper *ClientHelper) GetOrCreateWorkflowClient(namespace string) (client.Client, error) {
-	wc := helper.WorkflowClients.Get(namespace)
-	if wc == nil {
-		wc, err := client.NewClient(helper.Builder.clientOptions)
-		if err != nil {
-			return nil, err
-		}
-		_ = helper.WorkflowClients.Add(namespace, wc)
+// returns:
+//	- int64 -> the number of workflows matching the query.
+// 	- error -> error if one is thrown, nil if the method executed with no errors.
+func (helper *ClientHelper) CountWorkflow(ctx context.Context, namespace string, query string) (int64, error) {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return 0, err
 	}
 
-	return wc, nil
+	resp, err := workflowClient.CountWorkflow(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: namespace,
+		Query:     query,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.GetCount(), nil
 }
 
-// pollNamespace polls the temporal server to check and see if a connection
-// has been established by the service client by polling a namespace.
+// BatchTerminate starts a Temporal batch operation that terminates every
+// workflow matched by a visibility query.
 //
-// param ctx context.Context -> context to execute the namespace describe call on.
-// param channel chan error -> channel to send error over upon a connection
-// failure or nil if a connection was verified.
-// param namespace string -> the namespace to query for a connection.
+// params:
+//	- ctx context.Context -> the context to use to start the batch operation.
+// 	- namespace string -> the namespace to run the batch operation in.
+// 	- query string -> the SQL-like visibility query selecting the workflows.
+// 	- reason string -> the reason recorded for the batch operation.
+// 	- details []byte -> termination details to apply to each workflow.
 //
-// returns error -> error if establishing a connection failed and nil
-// upon success.
-func (helper *ClientHelper) pollNamespace(
-	ctx context.Context,
-	channel chan error,
-	namespace string,
-) error {
-	go func() {
-		var err error
-		defer func() {
-			channel <- err
-		}()
+// returns:
+//	- string -> the job id this call generated and sent with the batch
+//	operation.
+// 	- error -> error if one is thrown, nil if the method executed with no errors.
+func (helper *ClientHelper) BatchTerminate(ctx context.Context, namespace string, query string, reason string, details []byte) (string, error) {
+	jobID := uuid.New()
+	_, err := helper.serviceClient.StartBatchOperation(ctx, &workflowservice.StartBatchOperationRequest{
+		Namespace: namespace,
+		JobId:     jobID,
+		Reason:    reason,
+		VisibilityQuery: query,
+		Operation: &workflowservice.StartBatchOperationRequest_TerminationOperation{
+			TerminationOperation: &batchpb.BatchOperationTermination{
+				Details: details,
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
 
-		_, err = helper.DescribeNamespace(ctx, namespace)
-	}()
+	helper.Logger.Info("Batch Terminate Started", zap.String("JobId", jobID), zap.String("Query", query))
 
-	// block and catch the result
-	if err := <-channel; err != nil {
-		return err
+	return jobID, nil
+}
+
+// BatchCancel starts a Temporal batch operation that cancels every
+// workflow matched by a visibility query.
+//
+// params:
+//	- ctx context.Context -> the context to use to start the batch operation.
+// 	- namespace string -> the namespace to run the batch operation in.
+// 	- query string -> the SQL-like visibility query selecting the workflows.
+// 	- reason string -> the reason recorded for the batch operation.
+//
+// returns:
+//	- string -> the job id this call generated and sent with the batch
+//	operation.
+// 	- error -> error if one is thrown, nil if the method executed with no errors.
+func (helper *ClientHelper) BatchCancel(ctx context.Context, namespace string, query string, reason string) (string, error) {
+	jobID := uuid.New()
+	_, err := helper.serviceClient.StartBatchOperation(ctx, &workflowservice.StartBatchOperationRequest{
+		Namespace:       namespace,
+		JobId:           jobID,
+		Reason:          reason,
+		VisibilityQuery: query,
+		Operation: &workflowservice.StartBatchOperationRequest_CancellationOperation{
+			CancellationOperation: &batchpb.BatchOperationCancellation{},
+		},
+	})
+	if err != nil {
+		return "", err
 	}
 
-	return nil
+	helper.Logger.Info("Batch Cancel Started", zap.String("JobId", jobID), zap.String("Query", query))
+
+	return jobID, nil
 }
 
-//----------------------------------------------------------------------------
-// WorkflowClientsMap instance methods
+// BatchSignal starts a Temporal batch operation that signals every
+// workflow matched by a visibility query.
+//
+// params:
+//	- ctx context.Context -> the context to use to start the batch operation.
+// 	- namespace string -> the namespace to run the batch operation in.
+// 	- query string -> the SQL-like visibility query selecting the workflows.
+// 	- reason string -> the reason recorded for the batch operation.
+// 	- signalName string -> the name of the signal to deliver.
+// 	- signalArgs []byte -> the signal arguments to deliver.
+//
+// returns:
+//	- string -> the job id this call generated and sent with the batch
+//	operation.
+// 	- error -> error if one is thrown, nil if the method executed with no errors.
+func (helper *ClientHelper) BatchSignal(ctx context.Context, namespace string, query string, reason string, signalName string, signalArgs []byte) (string, error) {
+	jobID := uuid.New()
+	_, err := helper.serviceClient.StartBatchOperation(ctx, &workflowservice.StartBatchOperationRequest{
+		Namespace:       namespace,
+		JobId:           jobID,
+		Reason:          reason,
+		VisibilityQuery: query,
+		Operation: &workflowservice.StartBatchOperationRequest_SignalOperation{
+			SignalOperation: &batchpb.BatchOperationSignal{
+				Signal: signalName,
+				Input:  signalArgs,
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
 
-// NewWorkflowClientsMap is the constructor for an WorkflowClientsMap
-func NewWorkflowClientsMap() *WorkflowClientsMap {
-	o := new(WorkflowClientsMap)
-	o.clients = make(map[string]client.Client)
-	return o
+	helper.Logger.Info("Batch Signal Started", zap.String("JobId", jobID), zap.String("Query", query))
+
+	return jobID, nil
+}
+
+// batchResetTypeFromString maps a friendly reset type name, as accepted
+// elsewhere in the Temporal tooling, to its proto enum value. An empty or
+// unrecognized type resets to the start of the workflow's history.
+func batchResetTypeFromString(resetType string) batchpb.ResetType {
+	switch resetType {
+	case "LastWorkflowTask":
+		return batchpb.RESET_TYPE_LAST_WORKFLOW_TASK
+	case "LastContinuedAsNew":
+		return batchpb.RESET_TYPE_LAST_CONTINUED_AS_NEW
+	default:
+		return batchpb.RESET_TYPE_FIRST_WORKFLOW_TASK
+	}
 }
 
-// Add adds a new temporal WorkflowClient and its corresponding namespace into
-// the WorkflowClientsMap map.  This method is thread-safe.
+// BatchReset starts a Temporal batch operation that resets every
+// workflow matched by a visibility query.
 //
-// param namespace string -> the namespace for the temporal WorkflowClient.
-// This will be the mapped key.
-// param wc client.Client -> temporal WorkflowClient used to
-// execute workflow functions. This will be the mapped value.
+// params:
+//	- ctx context.Context -> the context to use to start the batch operation.
+// 	- namespace string -> the namespace to run the batch operation in.
+// 	- query string -> the SQL-like visibility query selecting the workflows.
+// 	- reason string -> the reason recorded for the batch operation.
+// 	- resetType string -> the point in each workflow's history to reset to.
 //
-// returns string -> the namespace for the temporal WorkflowClient added to the map.
-func (wcm *WorkflowClientsMap) Add(namespace string, wc client.Client) string {
-	wcm.Lock()
-	defer wcm.Unlock()
-	wcm.clients[namespace] = wc
-	return namespace
+// returns:
+//	- string -> the job id this call generated and sent with the batch
+//	operation.
+// 	- error -> error if one is thrown, nil if the method executed with no errors.
+func (helper *ClientHelper) BatchReset(ctx context.Context, namespace string, query string, reason string, resetType string) (string, error) {
+	jobID := uuid.New()
+	_, err := helper.serviceClient.StartBatchOperation(ctx, &workflowservice.StartBatchOperationRequest{
+		Namespace:       namespace,
+		JobId:           jobID,
+		Reason:          reason,
+		VisibilityQuery: query,
+		Operation: &workflowservice.StartBatchOperationRequest_ResetOperation{
+			ResetOperation: &batchpb.BatchOperationReset{
+				ResetType: batchResetTypeFromString(resetType),
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	helper.Logger.Info("Batch Reset Started", zap.String("JobId", jobID), zap.String("Query", query))
+
+	return jobID, nil
 }
 
-// Remove removes key/value entry from the WorkflowClientsMap map at the specified
-// ContextId.  This is a thread-safe method.
+// StopBatchJob stops a previously started Temporal batch operation job.
 //
-// param namespace string -> the namespace for the temporal WorkflowClient.
-// This will be the mapped key.
+// params:
+//	- ctx context.Context -> the context to use to stop the batch operation.
+// 	- jobID string -> the id of the batch job to stop, as returned by
+// 	BatchTerminate, BatchCancel, BatchSignal, or BatchReset.
+// 	- reason string -> the reason recorded for stopping the job.
 //
-// returns string -> the namespace for the temporal WorkflowClient removed from the map.
-func (wcm *WorkflowClientsMap) Remove(namespace string) string {
-	wcm.Lock()
-	defer wcm.Unlock()
-	delete(wcm.clients, namespace)
-	return namespace
+// returns error -> error if one is thrown, nil if the method executed with no errors.
+func (helper *ClientHelper) StopBatchJob(ctx context.Context, jobID string, reason string) error {
+	_, err := helper.serviceClient.StopBatchOperation(ctx, &workflowservice.StopBatchOperationRequest{
+		JobId:  jobID,
+		Reason: reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	helper.Logger.Info("Batch Job Stopped", zap.String("JobId", jobID))
+
+	return nil
 }
 
-// Get gets a WorkflowContext from the WorkflowClientsMap at the specified
-// ContextID.  This method is thread-safe.
+// DescribeBatchJob describes the progress of a previously started
+// Temporal batch operation job.
 //
-// param namespace string -> the namespace for the temporal WorkflowClient.
-// This will be the mapped key.
+// params:
+//	- ctx context.Context -> the context to use to describe the batch operation.
+// 	- jobID string -> the id of the batch job to describe, as returned by
+// 	BatchTerminate, BatchCancel, BatchSignal, or BatchReset.
 //
-// returns client.Client -> pointer to temporal WorkflowClient with the specified namespace.
-func (wcm *WorkflowClientsMap) Get(namespace string) client.Client {
-	wcm.Lock()
-	defer wcm.Unlock()
-	return wcm.clients[namespace]
+// returns:
+//	- *workflowservice.DescribeBatchOperationResponse -> the job's description.
+// 	- error -> error if one is thrown, nil if the method executed with no errors.
+func (helper *ClientHelper) DescribeBatchJob(ctx context.Context, jobID string) (*workflowservice.DescribeBatchOperationResponse, error) {
+	resp, err := helper.serviceClient.DescribeBatchOperation(ctx, &workflowservice.DescribeBatchOperationRequest{
+		JobId: jobID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
 }
+
+// ListBatchJobs lists the Temporal batch operation jobs started against
+// a namespace.
+//
+// params:
+//	- ctx context.Context -> the context to use to list the batch operations.
+// 	- namespace string -> the namespace to list batch jobs for.
+//
+// returns:
+//	- []*batchpb.BatchOperationInfo -> the batch jobs registered in the namespace.
+// 	- error -> error if one is thrown, nil if the method executed with no errors.
+func (helper *ClientHelper) ListBatchJobs(ctx context.Context, namespace string) ([]*batchpb.BatchOperationInfo, error) {
+	resp, err := helper.serviceClient.ListBatchOperations(ctx, &workflowservice.ListBatchOperationsRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetOperationInfo(), nil
+}
+
+// _connectionPoolValidationInterval bounds how often
+// GetOrCreateWorkflowClient re-validates a pooled client.Client with a
+// DescribeNamespace ping before handing it back, rather than pinging on
+// every single call.
+const _connectionPoolValidationInterval = 30 * time.Second
+
+// GetOrCreateWorkflowClient queries helper.WorkflowClients looking for a
+// temporal WorkflowClient at a specified namespace, validating it with a
+// DescribeNamespace ping -- see WorkflowClientsMap -- and evicting and
+// rebuilding it if that ping fails, before building and pooling a new one if
+// none was pooled at all.
+//
+// param namespace string -> the namespace of the temporal WorkflowClient.
+//
+// returns client.Client -> the WorkflowClient associated with
+// the specified namespace.
+func (helper *ClientHelper) GetOrCreateWorkflowClient(namespace string) (client.Client, error) {
+	if wc := helper.WorkflowClients.Get(namespace); wc != nil {
+		if !helper.WorkflowClients.NeedsValidation(namespace, _connectionPoolValidationInterval) {
+			return wc, nil
+		}
+
+		if _, err := helper.DescribeNamespace(context.Background(), namespace); err == nil {
+			helper.WorkflowClients.Touch(namespace)
+			helper.CircuitBreaker.Reset(namespace)
+			return wc, nil
+		}
+
+		helper.Logger.Warn("Evicting unhealthy pooled workflow client", zap.String("Namespace", namespace))
+		helper.WorkflowClients.Remove(namespace)
+		helper.CircuitBreaker.Trip(namespace)
+	}
+
+	wc, err := client.NewClient(helper.buildNamespaceClientOptions(namespace, helper.extraDialOptions()...))
+	if err != nil {
+		return nil, err
+	}
+	_ = helper.WorkflowClients.Add(namespace, wc)
+
+	return wc, nil
+}
+
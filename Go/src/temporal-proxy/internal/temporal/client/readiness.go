@@ -0,0 +1,233 @@
+//-----------------------------------------------------------------------------
+// FILE:		readiness.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+
+	// _connectionVerificationInitialInterval is the delay before
+	// WaitForReady's first retry of a transient DescribeNamespace failure,
+	// used when the caller passes a nil *RetryPolicy.
+	_connectionVerificationInitialInterval = 100 * time.Millisecond
+
+	// _connectionVerificationMaximumInterval caps how long WaitForReady
+	// waits between retries, used when the caller passes a nil
+	// *RetryPolicy.
+	_connectionVerificationMaximumInterval = 5 * time.Second
+)
+
+// newConnectionVerificationRetryPolicy is WaitForReady's default backoff,
+// used when the caller passes a nil *RetryPolicy: a tighter loop than
+// NewDefaultRetryPolicy's, since a caller blocked in WaitForReady -- usually
+// SetupServiceConfig, or a worker's own startup path -- wants to notice a
+// server coming back up quickly rather than waiting the minute-plus
+// NewDefaultRetryPolicy's 1s-initial/30s-cap backoff would take to get there.
+// MaximumAttempts matches NewDefaultRetryPolicy's, bounding the total wait
+// the way _namespacePollMaximumElapsedTime used to bound pollNamespace's.
+// IsRetryable is left nil: WaitForReady already returns ErrNamespaceNotExist
+// without consulting the policy once it sees _namespaceNotExistErrorStr, so
+// every error reaching ShouldRetry here is one worth retrying.
+func newConnectionVerificationRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		InitialInterval:    _connectionVerificationInitialInterval,
+		MaximumInterval:    _connectionVerificationMaximumInterval,
+		BackoffCoefficient: _namespacePollBackoffCoefficient,
+		Jitter:             0.2,
+		MaximumAttempts:    30,
+	}
+}
+
+// WaitForReady blocks until namespace's DescribeNamespace succeeds, ctx is
+// canceled, or policy gives up retrying -- replacing pollNamespace, which
+// spawned a goroutine per attempt that sent its result on the very channel
+// the caller was about to block reading from inside the same call, so
+// nothing outside pollNamespace ever observed it, and a slow DescribeNamespace
+// left that goroutine trying to send to a channel SetupServiceConfig's
+// `defer close` could close out from under it once ctx was canceled.
+//
+// Every namespace WaitForReady verifies successfully has its circuit breaker
+// reset (see CircuitBreaker), and the first namespace any caller verifies
+// closes the channel Readiness returns.
+//
+// params:
+//	- ctx context.Context -> canceling this stops retrying and returns
+//	ctx.Err().
+// 	- namespace string -> the namespace to verify.
+// 	- policy *RetryPolicy -> the backoff to retry with; nil uses
+// 	newConnectionVerificationRetryPolicy's default (100ms initial, 5s cap,
+// 	jittered).
+//
+// returns error -> ErrNamespaceNotExist if namespace doesn't exist on the
+// server, ctx.Err() if ctx is canceled first, the last error if policy gives
+// up retrying, or nil once namespace is verified.
+func (helper *ClientHelper) WaitForReady(ctx context.Context, namespace string, policy *RetryPolicy) error {
+	if policy == nil {
+		policy = newConnectionVerificationRetryPolicy()
+	}
+
+	for attempt := 1; ; attempt++ {
+		_, err := helper.DescribeNamespace(ctx, namespace)
+		if err == nil {
+			helper.markReady()
+			helper.CircuitBreaker.Reset(namespace)
+			return nil
+		}
+
+		if strings.Contains(err.Error(), _namespaceNotExistErrorStr) {
+			return fmt.Errorf("%w: %s", ErrNamespaceNotExist, namespace)
+		}
+
+		helper.CircuitBreaker.Trip(namespace)
+
+		if !policy.ShouldRetry(attempt, err) {
+			return err
+		}
+
+		helper.Logger.Warn("Retrying namespace verification",
+			zap.String("Namespace", namespace),
+			zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(policy.NextInterval(attempt)):
+		}
+	}
+}
+
+// Readiness returns a channel that is closed once WaitForReady has verified
+// any namespace, for worker startup code to block on the way the Temporal Go
+// SDK blocks on its own initial connection verification.
+func (helper *ClientHelper) Readiness() <-chan struct{} {
+	return helper.readinessChan
+}
+
+// markReady closes helper.readinessChan the first time it is called,
+// idempotently.
+func (helper *ClientHelper) markReady() {
+	helper.readyOnce.Do(func() {
+		close(helper.readinessChan)
+	})
+}
+
+type (
+
+	// namespaceCircuit is one namespace's circuit breaker state.
+	namespaceCircuit struct {
+		open     bool
+		closedCh chan struct{}
+	}
+
+	// NamespaceCircuitBreaker tracks, per namespace, whether the Temporal
+	// server is currently reachable, so CompleteActivity,
+	// CompleteActivityByID, RecordActivityHeartbeat, and
+	// RecordActivityHeartbeatByID can pause against a namespace that has
+	// gone unreachable -- rather than each failing its own RPC and
+	// leaving the caller to retry -- and resume automatically once
+	// WaitForReady, GetOrCreateWorkflowClient, or HealthCheck observes the
+	// namespace healthy again.
+	NamespaceCircuitBreaker struct {
+		mu       sync.Mutex
+		circuits map[string]*namespaceCircuit
+	}
+)
+
+// NewNamespaceCircuitBreaker is the default constructor for a
+// NamespaceCircuitBreaker, with every namespace starting closed.
+func NewNamespaceCircuitBreaker() *NamespaceCircuitBreaker {
+	return &NamespaceCircuitBreaker{
+		circuits: make(map[string]*namespaceCircuit),
+	}
+}
+
+// Trip opens namespace's circuit, if it isn't already open. This method is
+// thread-safe.
+func (breaker *NamespaceCircuitBreaker) Trip(namespace string) {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	circuit, ok := breaker.circuits[namespace]
+	if !ok {
+		circuit = &namespaceCircuit{}
+		breaker.circuits[namespace] = circuit
+	}
+
+	if circuit.open {
+		return
+	}
+
+	circuit.open = true
+	circuit.closedCh = make(chan struct{})
+}
+
+// Reset closes namespace's circuit, releasing every call blocked in Wait for
+// it. It is a no-op if namespace's circuit isn't open. This method is
+// thread-safe.
+func (breaker *NamespaceCircuitBreaker) Reset(namespace string) {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	circuit, ok := breaker.circuits[namespace]
+	if !ok || !circuit.open {
+		return
+	}
+
+	circuit.open = false
+	close(circuit.closedCh)
+}
+
+// IsOpen reports whether namespace's circuit is currently open. This method
+// is thread-safe.
+func (breaker *NamespaceCircuitBreaker) IsOpen(namespace string) bool {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	circuit, ok := breaker.circuits[namespace]
+
+	return ok && circuit.open
+}
+
+// Wait blocks until namespace's circuit is closed -- draining the caller's
+// RPC once the namespace recovers -- or ctx is canceled. It returns
+// immediately if namespace's circuit isn't open. This method is thread-safe.
+func (breaker *NamespaceCircuitBreaker) Wait(ctx context.Context, namespace string) error {
+	breaker.mu.Lock()
+	circuit, ok := breaker.circuits[namespace]
+	if !ok || !circuit.open {
+		breaker.mu.Unlock()
+		return nil
+	}
+	closedCh := circuit.closedCh
+	breaker.mu.Unlock()
+
+	select {
+	case <-closedCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
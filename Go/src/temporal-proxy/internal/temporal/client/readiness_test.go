@@ -0,0 +1,120 @@
+//-----------------------------------------------------------------------------
+// FILE:		readiness_test.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNamespaceCircuitBreakerTripAndReset(t *testing.T) {
+	breaker := NewNamespaceCircuitBreaker()
+
+	if breaker.IsOpen("ns") {
+		t.Fatal("expected a namespace's circuit to start closed")
+	}
+
+	breaker.Trip("ns")
+	if !breaker.IsOpen("ns") {
+		t.Fatal("expected Trip to open the circuit")
+	}
+
+	// Trip is idempotent -- tripping an already-open circuit must not panic
+	// or replace the channel Wait callers are already blocked on.
+	breaker.Trip("ns")
+	if !breaker.IsOpen("ns") {
+		t.Fatal("expected the circuit to remain open")
+	}
+
+	breaker.Reset("ns")
+	if breaker.IsOpen("ns") {
+		t.Fatal("expected Reset to close the circuit")
+	}
+
+	// Reset is a no-op on an already-closed circuit.
+	breaker.Reset("ns")
+}
+
+func TestNamespaceCircuitBreakerWaitReleasesOnReset(t *testing.T) {
+	breaker := NewNamespaceCircuitBreaker()
+	breaker.Trip("ns")
+
+	done := make(chan error, 1)
+	go func() {
+		done <- breaker.Wait(context.Background(), "ns")
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("expected Wait to block while the circuit is open")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	breaker.Reset("ns")
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("expected Wait to return nil once the circuit closed, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected Wait to unblock once the circuit closed")
+	}
+}
+
+func TestNamespaceCircuitBreakerWaitReturnsImmediatelyWhenClosed(t *testing.T) {
+	breaker := NewNamespaceCircuitBreaker()
+
+	if err := breaker.Wait(context.Background(), "never-tripped"); err != nil {
+		t.Fatalf("expected Wait to return immediately for a namespace with no open circuit, got %v", err)
+	}
+}
+
+func TestNamespaceCircuitBreakerWaitRespectsContextCancellation(t *testing.T) {
+	breaker := NewNamespaceCircuitBreaker()
+	breaker.Trip("ns")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := breaker.Wait(ctx, "ns"); err == nil {
+		t.Fatal("expected Wait to return ctx.Err() once ctx is canceled")
+	}
+}
+
+// TestNamespaceCircuitBreakerConcurrentTripReset exercises Trip/Reset/IsOpen
+// from many goroutines against the same namespace at once. Run with -race.
+func TestNamespaceCircuitBreakerConcurrentTripReset(t *testing.T) {
+	breaker := NewNamespaceCircuitBreaker()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				breaker.Trip("ns")
+				breaker.IsOpen("ns")
+				breaker.Reset("ns")
+			}
+		}()
+	}
+	wg.Wait()
+}
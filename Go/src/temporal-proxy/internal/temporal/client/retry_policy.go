@@ -0,0 +1,129 @@
+//-----------------------------------------------------------------------------
+// FILE:		retry_policy.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+type (
+
+	// RetryPolicy configures the exponential backoff a ClientHelper
+	// applies to its own retry loops -- building the service client in
+	// SetupServiceConfig, and propagating a newly registered namespace to
+	// ExecuteWorkflow -- replacing the fixed interval/attempt-count
+	// constants those loops used to carry on their own.
+	RetryPolicy struct {
+
+		// InitialInterval is the delay before the first retry.
+		InitialInterval time.Duration
+
+		// MaximumInterval caps the delay between retries, after
+		// BackoffCoefficient has been applied.
+		MaximumInterval time.Duration
+
+		// BackoffCoefficient is the multiplier applied to the retry
+		// interval after each attempt.
+		BackoffCoefficient float64
+
+		// Jitter is the fraction, from 0.0 to 1.0, of each computed
+		// interval to randomize by, so that many ClientHelpers retrying
+		// in lockstep -- e.g. after a shared Temporal frontend restart --
+		// don't all wake up and retry at exactly the same instant.
+		Jitter float64
+
+		// MaximumAttempts caps how many times a retry loop using this
+		// RetryPolicy retries before giving up.
+		MaximumAttempts int
+
+		// IsRetryable reports whether err should be retried at all. A nil
+		// IsRetryable retries every non-nil error.
+		IsRetryable func(err error) bool
+	}
+)
+
+// NewDefaultRetryPolicy is the default constructor for a RetryPolicy,
+// matching the fixed backoff pollNamespace already used before RetryPolicy
+// existed.
+//
+// returns *RetryPolicy -> a pointer to a newly initialized RetryPolicy.
+func NewDefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		InitialInterval:    _namespacePollInitialInterval,
+		MaximumInterval:    _namespacePollMaximumInterval,
+		BackoffCoefficient: _namespacePollBackoffCoefficient,
+		Jitter:             0.2,
+		MaximumAttempts:    30,
+		IsRetryable: func(err error) bool {
+			return err != nil && !strings.Contains(err.Error(), _namespaceNotExistErrorStr)
+		},
+	}
+}
+
+// NextInterval returns the delay to wait before retrying, after having just
+// made attempt (1-indexed): InitialInterval scaled by BackoffCoefficient^
+// (attempt-1), capped at MaximumInterval, then randomized by up to Jitter in
+// either direction.
+//
+// param attempt int -> the 1-indexed attempt number just made.
+//
+// returns time.Duration -> how long to wait before the next attempt.
+func (policy *RetryPolicy) NextInterval(attempt int) time.Duration {
+	interval := float64(policy.InitialInterval) * math.Pow(policy.BackoffCoefficient, float64(attempt-1))
+	if max := float64(policy.MaximumInterval); policy.MaximumInterval > 0 && interval > max {
+		interval = max
+	}
+
+	if policy.Jitter > 0 {
+		delta := interval * policy.Jitter
+		interval += (rand.Float64()*2 - 1) * delta
+		if interval < 0 {
+			interval = 0
+		}
+	}
+
+	return time.Duration(interval)
+}
+
+// ShouldRetry reports whether the caller should retry after attempt
+// (1-indexed) failed with err, per MaximumAttempts and IsRetryable.
+//
+// params:
+//	- attempt int -> the 1-indexed attempt number that just failed.
+// 	- err error -> the error that attempt failed with.
+//
+// returns bool -> true if the caller should wait NextInterval(attempt) and
+// retry, false if it should give up and return err.
+func (policy *RetryPolicy) ShouldRetry(attempt int, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if policy.MaximumAttempts > 0 && attempt >= policy.MaximumAttempts {
+		return false
+	}
+
+	if policy.IsRetryable != nil && !policy.IsRetryable(err) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,325 @@
+//-----------------------------------------------------------------------------
+// FILE:		retryable_client_helper.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/temporal-proto/workflowservice"
+	"go.temporal.io/temporal/client"
+	"go.temporal.io/temporal/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+
+	// _serviceBusyErrorStr is the string message of the error thrown by
+	// temporal when the server is temporarily overloaded and the caller
+	// should back off and retry.
+	_serviceBusyErrorStr = "ServiceBusyError"
+
+	// _internalServiceErrorStr is the string message of the error thrown by
+	// temporal when it encounters an unexpected, likely transient, internal
+	// failure.
+	_internalServiceErrorStr = "InternalServiceError"
+
+	// _contextDeadlineExceededStr is the error message returned when a call
+	// to the temporal server times out client-side before it completes.
+	_contextDeadlineExceededStr = "context deadline exceeded"
+)
+
+type (
+
+	// RetryPolicy specifies how a RetryableClientHelper should back off and
+	// retry a ClientHelper call after it fails with a transient error.
+	//
+	// Contains:
+	//	- time.Duration -> the amount of time to wait before the first retry.
+	//	- float64 -> the multiplier applied to the interval after each retry.
+	//	- time.Duration -> the maximum amount of time to wait between retries.
+	//	- int -> the maximum number of attempts to make before giving up.
+	RetryPolicy struct {
+		InitialInterval    time.Duration
+		BackoffCoefficient float64
+		MaximumInterval    time.Duration
+		MaximumAttempts    int
+	}
+
+	// RetryableClientHelper wraps a ClientHelper and transparently retries
+	// the calls that are most exposed to transient temporal server errors
+	// (ExecuteWorkflow, CancelWorkflow, TerminateWorkflow, SignalWorkflow,
+	// SignalWithStartWorkflow, DescribeWorkflowExecution, and GetWorkflow),
+	// classifying errors with IsTransientError before retrying.
+	//
+	// Nothing in the proxy wraps a ClientHelper in a RetryableClientHelper
+	// when it is added to the Clients registry: a caller retrieving the
+	// client helper for a clientID gets the plain ClientHelper, and only a
+	// caller that builds one with NewRetryableClientHelper gets the retry
+	// behavior.
+	//
+	// Contains:
+	//	- *ClientHelper -> the underlying ClientHelper being wrapped.
+	//	- RetryPolicy -> the policy governing how retries are performed.
+	RetryableClientHelper struct {
+		*ClientHelper
+		retryPolicyMu sync.RWMutex
+		retryPolicy   RetryPolicy
+	}
+)
+
+// _defaultRetryPolicy is the RetryPolicy applied to a RetryableClientHelper
+// that has not had an explicit policy set.
+var _defaultRetryPolicy = RetryPolicy{
+	InitialInterval:    time.Second,
+	BackoffCoefficient: 2.0,
+	MaximumInterval:    time.Second * 30,
+	MaximumAttempts:    5,
+}
+
+// NewRetryableClientHelper is the default constructor for a
+// RetryableClientHelper.
+//
+// params:
+//	- helper *ClientHelper -> the ClientHelper to wrap with retry behavior.
+//
+// returns *RetryableClientHelper -> pointer to a newly created
+// RetryableClientHelper wrapping helper and using the default RetryPolicy.
+func NewRetryableClientHelper(helper *ClientHelper) *RetryableClientHelper {
+	retryableHelper := new(RetryableClientHelper)
+	retryableHelper.ClientHelper = helper
+	retryableHelper.retryPolicy = _defaultRetryPolicy
+
+	return retryableHelper
+}
+
+// GetRetryPolicy gets the RetryPolicy currently applied by a
+// RetryableClientHelper. This method is thread-safe.
+//
+// returns RetryPolicy -> the RetryPolicy currently in effect.
+func (helper *RetryableClientHelper) GetRetryPolicy() RetryPolicy {
+	helper.retryPolicyMu.RLock()
+	defer helper.retryPolicyMu.RUnlock()
+
+	return helper.retryPolicy
+}
+
+// SetRetryPolicy sets the RetryPolicy to be applied by a
+// RetryableClientHelper. This allows the retry behavior of an already
+// registered client helper to be overridden at runtime, for example in
+// response to a WorkflowSetRetryPolicyRequest. This method is thread-safe.
+//
+// params value RetryPolicy -> the RetryPolicy to apply to subsequent calls.
+func (helper *RetryableClientHelper) SetRetryPolicy(value RetryPolicy) {
+	helper.retryPolicyMu.Lock()
+	defer helper.retryPolicyMu.Unlock()
+
+	helper.retryPolicy = value
+}
+
+// IsTransientError determines whether err represents a temporal server or
+// transport failure that is likely to succeed if the caller simply tries
+// the same call again, as opposed to an error describing why the call can
+// never succeed (e.g. the workflow was not found, or was already started).
+//
+// params err error -> the error returned by a ClientHelper or temporal
+// client call.
+//
+// returns bool -> true if err is transient and the call is worth retrying.
+func IsTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	message := err.Error()
+
+	return strings.Contains(message, _serviceBusyErrorStr) ||
+		strings.Contains(message, _internalServiceErrorStr) ||
+		strings.Contains(message, _contextDeadlineExceededStr)
+}
+
+// withRetry invokes op, retrying it according to helper's RetryPolicy as
+// long as op keeps failing with a transient error (per IsTransientError),
+// ctx has not been cancelled, and the policy's MaximumAttempts has not been
+// reached. The first non-transient error, or the last transient error once
+// retries are exhausted, is returned to the caller.
+//
+// params:
+//	- ctx context.Context -> the context governing the overall call,
+// 	including any retries.
+// 	- op func() error -> the operation to invoke and potentially retry.
+//
+// returns error -> the error returned by the last invocation of op, or nil
+// if op eventually succeeded.
+func (helper *RetryableClientHelper) withRetry(ctx context.Context, op func() error) error {
+	policy := helper.GetRetryPolicy()
+	interval := policy.InitialInterval
+
+	var err error
+	for attempt := 1; attempt <= policy.MaximumAttempts; attempt++ {
+		err = op()
+		if err == nil || !IsTransientError(err) {
+			return err
+		}
+
+		if attempt == policy.MaximumAttempts {
+			break
+		}
+
+		helper.Logger.Warn("Retrying transient temporal client error",
+			zap.Int("Attempt", attempt),
+			zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(interval):
+		}
+
+		interval = time.Duration(float64(interval) * policy.BackoffCoefficient)
+		if interval > policy.MaximumInterval {
+			interval = policy.MaximumInterval
+		}
+	}
+
+	return err
+}
+
+// ExecuteWorkflow inherits docs from ClientHelper.ExecuteWorkflow, retrying
+// the call according to helper's RetryPolicy.
+func (helper *RetryableClientHelper) ExecuteWorkflow(
+	ctx context.Context,
+	namespace string,
+	options client.StartWorkflowOptions,
+	workflow interface{},
+	args ...interface{},
+) (client.WorkflowRun, error) {
+	var workflowRun client.WorkflowRun
+	err := helper.withRetry(ctx, func() error {
+		var err error
+		workflowRun, err = helper.ClientHelper.ExecuteWorkflow(ctx, namespace, options, workflow, args...)
+		return err
+	})
+
+	return workflowRun, err
+}
+
+// CancelWorkflow inherits docs from ClientHelper.CancelWorkflow, retrying
+// the call according to helper's RetryPolicy.
+func (helper *RetryableClientHelper) CancelWorkflow(
+	ctx context.Context,
+	workflowID string,
+	runID string,
+	namespace string,
+) error {
+	return helper.withRetry(ctx, func() error {
+		return helper.ClientHelper.CancelWorkflow(ctx, workflowID, runID, namespace)
+	})
+}
+
+// TerminateWorkflow inherits docs from ClientHelper.TerminateWorkflow,
+// retrying the call according to helper's RetryPolicy.
+func (helper *RetryableClientHelper) TerminateWorkflow(
+	ctx context.Context,
+	workflowID string,
+	runID string,
+	namespace string,
+	reason string,
+	details []byte,
+) error {
+	return helper.withRetry(ctx, func() error {
+		return helper.ClientHelper.TerminateWorkflow(ctx, workflowID, runID, namespace, reason, details)
+	})
+}
+
+// SignalWithStartWorkflow inherits docs from
+// ClientHelper.SignalWithStartWorkflow, retrying the call according to
+// helper's RetryPolicy.
+func (helper *RetryableClientHelper) SignalWithStartWorkflow(
+	ctx context.Context,
+	workflowID string,
+	namespace string,
+	signalName string,
+	signalArg []byte,
+	opts client.StartWorkflowOptions,
+	workflow string,
+	args ...interface{},
+) (*workflow.Execution, error) {
+	var workflowExecution *workflow.Execution
+	err := helper.withRetry(ctx, func() error {
+		var err error
+		workflowExecution, err = helper.ClientHelper.SignalWithStartWorkflow(ctx, workflowID, namespace, signalName, signalArg, opts, workflow, args...)
+		return err
+	})
+
+	return workflowExecution, err
+}
+
+// DescribeWorkflowExecution inherits docs from
+// ClientHelper.DescribeWorkflowExecution, retrying the call according to
+// helper's RetryPolicy.
+func (helper *RetryableClientHelper) DescribeWorkflowExecution(
+	ctx context.Context,
+	workflowID string,
+	runID string,
+	namespace string,
+) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+	var response *workflowservice.DescribeWorkflowExecutionResponse
+	err := helper.withRetry(ctx, func() error {
+		var err error
+		response, err = helper.ClientHelper.DescribeWorkflowExecution(ctx, workflowID, runID, namespace)
+		return err
+	})
+
+	return response, err
+}
+
+// SignalWorkflow inherits docs from ClientHelper.SignalWorkflow, retrying
+// the call according to helper's RetryPolicy.
+func (helper *RetryableClientHelper) SignalWorkflow(
+	ctx context.Context,
+	workflowID string,
+	runID string,
+	namespace string,
+	signalName string,
+	arg interface{},
+) error {
+	return helper.withRetry(ctx, func() error {
+		return helper.ClientHelper.SignalWorkflow(ctx, workflowID, runID, namespace, signalName, arg)
+	})
+}
+
+// GetWorkflow inherits docs from ClientHelper.GetWorkflow, retrying the
+// call according to helper's RetryPolicy.
+func (helper *RetryableClientHelper) GetWorkflow(
+	ctx context.Context,
+	workflowID string,
+	runID string,
+	namespace string,
+) (client.WorkflowRun, error) {
+	var workflowRun client.WorkflowRun
+	err := helper.withRetry(ctx, func() error {
+		var err error
+		workflowRun, err = helper.ClientHelper.GetWorkflow(ctx, workflowID, runID, namespace)
+		return err
+	})
+
+	return workflowRun, err
+}
@@ -0,0 +1,124 @@
+//-----------------------------------------------------------------------------
+// FILE:		retryable_client_helper_test.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func newTestRetryableClientHelper() *RetryableClientHelper {
+	return NewRetryableClientHelper(&ClientHelper{Logger: zap.NewNop()})
+}
+
+// TestRetryableClientHelperRetryPolicyRace runs SetRetryPolicy concurrently
+// with GetRetryPolicy and withRetry, the concurrent access pattern
+// WorkflowSetRetryPolicyRequest exercises against a RetryableClientHelper
+// shared by every in-flight call for a client. Run with -race.
+func TestRetryableClientHelperRetryPolicyRace(t *testing.T) {
+	helper := newTestRetryableClientHelper()
+	stop := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				helper.SetRetryPolicy(RetryPolicy{
+					InitialInterval:    time.Millisecond,
+					BackoffCoefficient: 2.0,
+					MaximumInterval:    10 * time.Millisecond,
+					MaximumAttempts:    1,
+				})
+			}
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				_ = helper.GetRetryPolicy()
+				_ = helper.withRetry(context.Background(), func() error { return nil })
+			}
+		}
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	close(stop)
+	wg.Wait()
+}
+
+func TestWithRetryStopsOnNonTransientError(t *testing.T) {
+	helper := newTestRetryableClientHelper()
+	helper.SetRetryPolicy(RetryPolicy{
+		InitialInterval:    time.Millisecond,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    10 * time.Millisecond,
+		MaximumAttempts:    5,
+	})
+
+	var calls int
+	err := helper.withRetry(context.Background(), func() error {
+		calls++
+		return errors.New("NotFoundError: workflow not found")
+	})
+
+	if err == nil {
+		t.Fatal("expected a non-nil error")
+	}
+	if calls != 1 {
+		t.Fatalf("expected withRetry to give up after the first non-transient error, got %d calls", calls)
+	}
+}
+
+func TestWithRetryExhaustsMaximumAttempts(t *testing.T) {
+	helper := newTestRetryableClientHelper()
+	helper.SetRetryPolicy(RetryPolicy{
+		InitialInterval:    time.Millisecond,
+		BackoffCoefficient: 1.0,
+		MaximumInterval:    time.Millisecond,
+		MaximumAttempts:    3,
+	})
+
+	var calls int
+	err := helper.withRetry(context.Background(), func() error {
+		calls++
+		return errors.New("ServiceBusyError: server overloaded")
+	})
+
+	if err == nil {
+		t.Fatal("expected a non-nil error")
+	}
+	if calls != 3 {
+		t.Fatalf("expected withRetry to attempt MaximumAttempts (3) times, got %d", calls)
+	}
+}
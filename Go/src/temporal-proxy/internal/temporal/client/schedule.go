@@ -0,0 +1,358 @@
+//-----------------------------------------------------------------------------
+// FILE:		schedule.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"context"
+	"time"
+
+	temporalclient "go.temporal.io/sdk/client"
+	"go.uber.org/zap"
+)
+
+// _buildIDsSearchAttribute is the name of the internal search attribute
+// Temporal uses to track worker build IDs on a schedule's workflow
+// executions.  The Neon.Temporal .NET library has no use for it, so
+// schedule descriptions hide it the same way upstream Temporal does.
+const _buildIDsSearchAttribute = "BuildIds"
+
+// _reservedScheduleSearchAttributes are the search attributes Temporal
+// stamps onto every workflow execution a schedule starts. They're an
+// implementation detail of the schedule, not data the .NET client asked
+// for, so schedule descriptions hide them the same way BuildIds is hidden.
+var _reservedScheduleSearchAttributes = []string{
+	"TemporalScheduledById",
+	"TemporalScheduledStartTime",
+}
+
+// ScheduleCreate creates a new temporal schedule that will periodically
+// start the action described in options.
+//
+// params:
+//	- ctx context.Context -> the context to use to create the schedule.
+// 	- namespace string -> the namespace to create the schedule in.
+// 	- scheduleID string -> the unique id for the new schedule.
+// 	- options temporalclient.ScheduleOptions -> the spec, action, overlap
+// 	policy, and catchup window for the new schedule.
+//
+// returns:
+//	- temporalclient.ScheduleHandle -> handle to the newly created schedule.
+// 	- error -> error if the schedule could not be created, nil on success.
+func (helper *ClientHelper) ScheduleCreate(
+	ctx context.Context,
+	namespace string,
+	scheduleID string,
+	options temporalclient.ScheduleOptions,
+) (temporalclient.ScheduleHandle, error) {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	options.ID = scheduleID
+	handle, err := workflowClient.ScheduleClient().Create(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	helper.Logger.Info("Schedule Created", zap.String("ScheduleID", scheduleID))
+
+	return handle, nil
+}
+
+// ScheduleDescribe describes an existing temporal schedule, including its
+// spec, recent actions, and next scheduled fire times.
+//
+// params:
+//	- ctx context.Context -> the context to use to describe the schedule.
+// 	- namespace string -> the namespace the schedule belongs to.
+// 	- scheduleID string -> the id of the schedule to describe.
+//
+// returns:
+//	- *temporalclient.ScheduleDescription -> the schedule description.
+// 	- error -> error if the schedule could not be described, nil on success.
+func (helper *ClientHelper) ScheduleDescribe(
+	ctx context.Context,
+	namespace string,
+	scheduleID string,
+) (*temporalclient.ScheduleDescription, error) {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := workflowClient.ScheduleClient().GetHandle(ctx, scheduleID).Describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// hide the BuildIds search attribute, since the .NET client has no
+	// way to interpret it.
+	if description.SearchAttributes != nil {
+		delete(description.SearchAttributes.IndexedFields, _buildIDsSearchAttribute)
+	}
+
+	// hide the reserved TemporalScheduledById/TemporalScheduledStartTime
+	// search attributes Temporal stamps onto the schedule's action, since
+	// they're an implementation detail rather than data the .NET client set.
+	if action, ok := description.Schedule.Action.(*temporalclient.ScheduleWorkflowAction); ok && action.SearchAttributes != nil {
+		for _, name := range _reservedScheduleSearchAttributes {
+			delete(action.SearchAttributes, name)
+		}
+	}
+
+	helper.Logger.Info("Schedule Describe Response", zap.String("ScheduleID", scheduleID))
+
+	return description, nil
+}
+
+// ScheduleUpdate updates the spec of an existing temporal schedule.
+//
+// params:
+//	- ctx context.Context -> the context to use to update the schedule.
+// 	- namespace string -> the namespace the schedule belongs to.
+// 	- scheduleID string -> the id of the schedule to update.
+// 	- spec *temporalclient.ScheduleSpec -> the new calendar/interval/cron spec.
+//
+// returns error -> error if the schedule could not be updated, nil on success.
+func (helper *ClientHelper) ScheduleUpdate(
+	ctx context.Context,
+	namespace string,
+	scheduleID string,
+	spec *temporalclient.ScheduleSpec,
+) error {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return err
+	}
+
+	handle := workflowClient.ScheduleClient().GetHandle(ctx, scheduleID)
+	err = handle.Update(ctx, temporalclient.ScheduleUpdateOptions{
+		DoUpdate: func(input temporalclient.ScheduleUpdateInput) (*temporalclient.ScheduleUpdate, error) {
+			if spec != nil {
+				input.Description.Schedule.Spec = spec
+			}
+			return &temporalclient.ScheduleUpdate{Schedule: &input.Description.Schedule}, nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	helper.Logger.Info("Schedule Updated", zap.String("ScheduleID", scheduleID))
+
+	return nil
+}
+
+// ScheduleDelete deletes an existing temporal schedule.
+//
+// params:
+//	- ctx context.Context -> the context to use to delete the schedule.
+// 	- namespace string -> the namespace the schedule belongs to.
+// 	- scheduleID string -> the id of the schedule to delete.
+//
+// returns error -> error if the schedule could not be deleted, nil on success.
+func (helper *ClientHelper) ScheduleDelete(ctx context.Context, namespace string, scheduleID string) error {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return err
+	}
+
+	err = workflowClient.ScheduleClient().GetHandle(ctx, scheduleID).Delete(ctx)
+	if err != nil {
+		return err
+	}
+
+	helper.Logger.Info("Schedule Deleted", zap.String("ScheduleID", scheduleID))
+
+	return nil
+}
+
+// ScheduleList lists the schedules registered in a namespace.
+//
+// params:
+//	- ctx context.Context -> the context to use to list the schedules.
+// 	- namespace string -> the namespace to list schedules for.
+// 	- pageSize int32 -> the maximum number of schedules to return per page.
+//
+// returns:
+//	- []*temporalclient.ScheduleListEntry -> the list of matching schedules.
+// 	- error -> error if the schedules could not be listed, nil on success.
+func (helper *ClientHelper) ScheduleList(
+	ctx context.Context,
+	namespace string,
+	pageSize int32,
+) ([]*temporalclient.ScheduleListEntry, error) {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := workflowClient.ScheduleClient().List(ctx, temporalclient.ScheduleListOptions{PageSize: int(pageSize)})
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*temporalclient.ScheduleListEntry
+	for iter.HasNext() {
+		entry, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	helper.Logger.Info("Schedule List Response", zap.Int("Count", len(entries)))
+
+	return entries, nil
+}
+
+// ScheduleTrigger triggers an immediate, out-of-band run of a schedule's
+// action, subject to the given overlap policy.
+//
+// params:
+//	- ctx context.Context -> the context to use to trigger the schedule.
+// 	- namespace string -> the namespace the schedule belongs to.
+// 	- scheduleID string -> the id of the schedule to trigger.
+// 	- overlap temporalclient.ScheduleOverlapPolicy -> the overlap policy to
+// 	apply to the triggered run.
+//
+// returns error -> error if the schedule could not be triggered, nil on success.
+func (helper *ClientHelper) ScheduleTrigger(
+	ctx context.Context,
+	namespace string,
+	scheduleID string,
+	overlap temporalclient.ScheduleOverlapPolicy,
+) error {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return err
+	}
+
+	err = workflowClient.ScheduleClient().GetHandle(ctx, scheduleID).Trigger(ctx, temporalclient.ScheduleTriggerOptions{
+		Overlap: overlap,
+	})
+	if err != nil {
+		return err
+	}
+
+	helper.Logger.Info("Schedule Triggered", zap.String("ScheduleID", scheduleID))
+
+	return nil
+}
+
+// SchedulePause pauses an existing temporal schedule so it stops taking
+// new actions.
+//
+// params:
+//	- ctx context.Context -> the context to use to pause the schedule.
+// 	- namespace string -> the namespace the schedule belongs to.
+// 	- scheduleID string -> the id of the schedule to pause.
+// 	- note string -> explanation recorded on the schedule for why it was paused.
+//
+// returns error -> error if the schedule could not be paused, nil on success.
+func (helper *ClientHelper) SchedulePause(ctx context.Context, namespace string, scheduleID string, note string) error {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return err
+	}
+
+	err = workflowClient.ScheduleClient().GetHandle(ctx, scheduleID).Pause(ctx, temporalclient.SchedulePauseOptions{
+		Note: note,
+	})
+	if err != nil {
+		return err
+	}
+
+	helper.Logger.Info("Schedule Paused", zap.String("ScheduleID", scheduleID), zap.String("Note", note))
+
+	return nil
+}
+
+// ScheduleUnpause unpauses a previously paused temporal schedule so it
+// resumes taking actions.
+//
+// params:
+//	- ctx context.Context -> the context to use to unpause the schedule.
+// 	- namespace string -> the namespace the schedule belongs to.
+// 	- scheduleID string -> the id of the schedule to unpause.
+// 	- note string -> explanation recorded on the schedule for why it was unpaused.
+//
+// returns error -> error if the schedule could not be unpaused, nil on success.
+func (helper *ClientHelper) ScheduleUnpause(ctx context.Context, namespace string, scheduleID string, note string) error {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return err
+	}
+
+	err = workflowClient.ScheduleClient().GetHandle(ctx, scheduleID).Unpause(ctx, temporalclient.ScheduleUnpauseOptions{
+		Note: note,
+	})
+	if err != nil {
+		return err
+	}
+
+	helper.Logger.Info("Schedule Unpaused", zap.String("ScheduleID", scheduleID), zap.String("Note", note))
+
+	return nil
+}
+
+// ScheduleBackfill executes a temporal schedule's action as though it had
+// fired at every scheduled time within [startTime, endTime], subject to
+// overlap.
+//
+// params:
+//	- ctx context.Context -> the context to use to backfill the schedule.
+// 	- namespace string -> the namespace the schedule belongs to.
+// 	- scheduleID string -> the id of the schedule to backfill.
+// 	- startTime time.Time -> the start of the time range to backfill, inclusive.
+// 	- endTime time.Time -> the end of the time range to backfill, inclusive.
+// 	- overlap temporalclient.ScheduleOverlapPolicy -> the overlap policy to
+// 	apply to the backfilled runs.
+//
+// returns error -> error if the schedule could not be backfilled, nil on success.
+func (helper *ClientHelper) ScheduleBackfill(
+	ctx context.Context,
+	namespace string,
+	scheduleID string,
+	startTime time.Time,
+	endTime time.Time,
+	overlap temporalclient.ScheduleOverlapPolicy,
+) error {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return err
+	}
+
+	err = workflowClient.ScheduleClient().GetHandle(ctx, scheduleID).Backfill(ctx, temporalclient.ScheduleBackfillOptions{
+		Backfill: []temporalclient.ScheduleBackfill{
+			{
+				Start:   startTime,
+				End:     endTime,
+				Overlap: overlap,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	helper.Logger.Info("Schedule Backfilled", zap.String("ScheduleID", scheduleID))
+
+	return nil
+}
@@ -0,0 +1,77 @@
+//-----------------------------------------------------------------------------
+// FILE:		task_token.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// _taskTokenNamespaceIDField is the field number Temporal's activity
+// task token reserves for the id (not name) of the namespace the task
+// belongs to -- the same field the Temporal frontend's own
+// EnableTokenNamespaceEnforcement dynamic config reads to reject a
+// completion/heartbeat call made against the wrong namespace.
+const _taskTokenNamespaceIDField = 1
+
+// decodeTaskTokenNamespaceID extracts the NamespaceId embedded in a
+// Temporal activity task token, so enforceTaskTokenNamespace can compare
+// it against the namespace a CompleteActivity call claims to be acting
+// on.
+//
+// NOTE: this only decodes field _taskTokenNamespaceIDField of the task
+// token's wire format rather than depending on the server-internal task
+// token proto package, which isn't vendored in this tree -- every other
+// field the token carries (WorkflowId, RunId, ActivityId, ...) is
+// skipped over unread.
+//
+// param taskToken []byte -> the opaque task token handed back to the
+// worker by the Temporal server.
+//
+// returns:
+//	- string -> the namespace id the task token was issued for.
+// 	- error -> a non-nil error if taskToken couldn't be parsed as a
+//	protobuf message, or never carries the namespace id field.
+func decodeTaskTokenNamespaceID(taskToken []byte) (string, error) {
+	for len(taskToken) > 0 {
+		num, typ, n := protowire.ConsumeTag(taskToken)
+		if n < 0 {
+			return "", fmt.Errorf("task token: malformed tag: %w", protowire.ParseError(n))
+		}
+		taskToken = taskToken[n:]
+
+		if num == _taskTokenNamespaceIDField && typ == protowire.BytesType {
+			value, n := protowire.ConsumeBytes(taskToken)
+			if n < 0 {
+				return "", fmt.Errorf("task token: malformed namespace id field: %w", protowire.ParseError(n))
+			}
+
+			return string(value), nil
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, taskToken)
+		if n < 0 {
+			return "", fmt.Errorf("task token: malformed field %d: %w", num, protowire.ParseError(n))
+		}
+		taskToken = taskToken[n:]
+	}
+
+	return "", errors.New("task token: namespace id field not present")
+}
@@ -0,0 +1,60 @@
+//-----------------------------------------------------------------------------
+// FILE:		update.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"context"
+
+	temporalclient "go.temporal.io/sdk/client"
+	"go.uber.org/zap"
+)
+
+// UpdateWorkflow sends a Temporal Update to a workflow execution, waiting
+// for the update to reach the requested lifecycle stage before returning.
+//
+// params:
+//	- ctx context.Context -> the context to use to send the update.
+// 	- namespace string -> the namespace the workflow is executing on.
+// 	- options temporalclient.UpdateWorkflowOptions -> the workflow/run id,
+// 	update name/id, arguments, and the stage to wait for.
+//
+// returns:
+//	- temporalclient.WorkflowUpdateHandle -> a handle that can be used to
+// 	wait for and retrieve the update's result.
+// 	- error -> error if the update could not be sent, nil on success.
+func (helper *ClientHelper) UpdateWorkflow(
+	ctx context.Context,
+	namespace string,
+	options temporalclient.UpdateWorkflowOptions,
+) (temporalclient.WorkflowUpdateHandle, error) {
+	workflowClient, err := helper.GetOrCreateWorkflowClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	handle, err := workflowClient.UpdateWorkflow(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	helper.Logger.Info("Workflow Update Sent",
+		zap.String("WorkflowID", options.WorkflowID),
+		zap.String("UpdateID", handle.UpdateID()))
+
+	return handle, nil
+}
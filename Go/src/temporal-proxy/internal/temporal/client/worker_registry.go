@@ -0,0 +1,290 @@
+//-----------------------------------------------------------------------------
+// FILE:		worker_registry.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.temporal.io/temporal/activity"
+	"go.temporal.io/temporal/worker"
+	"go.uber.org/zap"
+)
+
+type (
+
+	// WorkerState is the lifecycle state of a worker tracked in a
+	// WorkerRegistry.
+	WorkerState string
+
+	// workerRecord is the bookkeeping a WorkerRegistry keeps for one
+	// worker.Worker, enough to restart or gracefully drain it later by
+	// workerID alone, without the caller having to remember the
+	// namespace/taskList/options it was started with.
+	workerRecord struct {
+		worker        worker.Worker
+		namespace     string
+		taskList      string
+		options       worker.Options
+		state         WorkerState
+		lastPollError error
+	}
+
+	// WorkerRegistry is a thread-safe registry of every worker.Worker a
+	// ClientHelper has started, keyed by workerID alone, so it can be
+	// looked up, restarted, or drained by that ID. Each record stores its
+	// namespace and taskList, but they are not part of the key: a second
+	// worker added under the same workerID replaces the first, whatever
+	// its namespace or taskList.
+	WorkerRegistry struct {
+		mu      sync.Mutex
+		workers map[int64]*workerRecord
+	}
+
+	// WorkerStatus is a point-in-time snapshot of one worker's identity
+	// and lifecycle state, returned by ListWorkers and WorkerHealth.
+	//
+	// NOTE: worker.Worker does not surface poller counts, outstanding
+	// task counts, or a last-poll-error callback anywhere in this
+	// tree's SDK surface, so PollerCount/OutstandingTasks are always 0
+	// and LastPollError is always nil until the SDK exposes a hook to
+	// populate them.
+	WorkerStatus struct {
+		WorkerID         int64
+		Namespace        string
+		TaskList         string
+		State            WorkerState
+		PollerCount      int
+		OutstandingTasks int
+		LastPollError    error
+	}
+)
+
+const (
+
+	// WorkerStateRunning indicates a worker is polling for new tasks.
+	WorkerStateRunning WorkerState = "running"
+
+	// WorkerStateDraining indicates a worker has stopped polling for new
+	// tasks and is waiting for in-flight activities/workflow tasks to
+	// complete, up to StopWorkerGracefully's drainTimeout.
+	WorkerStateDraining WorkerState = "draining"
+
+	// WorkerStateStopped indicates a worker has been fully stopped.
+	WorkerStateStopped WorkerState = "stopped"
+)
+
+// NewWorkerRegistry is the default constructor for a WorkerRegistry.
+//
+// returns *WorkerRegistry -> a pointer to a new, empty WorkerRegistry.
+func NewWorkerRegistry() *WorkerRegistry {
+	return &WorkerRegistry{
+		workers: make(map[int64]*workerRecord),
+	}
+}
+
+// add registers w under workerID, replacing any worker already registered
+// under that ID. This method is thread-safe.
+func (registry *WorkerRegistry) add(workerID int64, namespace string, taskList string, options worker.Options, w worker.Worker) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.workers[workerID] = &workerRecord{
+		worker:    w,
+		namespace: namespace,
+		taskList:  taskList,
+		options:   options,
+		state:     WorkerStateRunning,
+	}
+}
+
+// get returns the workerRecord registered under workerID, or nil if none is
+// registered. This method is thread-safe.
+func (registry *WorkerRegistry) get(workerID int64) *workerRecord {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	return registry.workers[workerID]
+}
+
+// remove deregisters workerID. This method is thread-safe.
+func (registry *WorkerRegistry) remove(workerID int64) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	delete(registry.workers, workerID)
+}
+
+// setState sets the WorkerState registered under workerID, if one is
+// registered. This method is thread-safe.
+func (registry *WorkerRegistry) setState(workerID int64, state WorkerState) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if record, ok := registry.workers[workerID]; ok {
+		record.state = state
+	}
+}
+
+// StopWorkerGracefully stops workerID from polling for new tasks, then waits
+// up to drainTimeout for its in-flight activities and workflow tasks to
+// complete before returning, matching the drain semantics the Temporal SDK's
+// task pollers already implement in worker.Worker.Stop -- this just bounds
+// how long the caller waits for that drain before moving on.
+//
+// NOTE: worker.Worker.Stop does not accept a context or deadline of its own,
+// so when drainTimeout elapses first, this returns without forcing the
+// drain to abandon any in-flight task -- it keeps draining in the
+// background, and the worker's WorkerStatus is left in WorkerStateDraining
+// until it finishes.
+//
+// params:
+//	- workerID int64 -> the workerID of the worker to stop.
+// 	- drainTimeout time.Duration -> how long to wait for in-flight work to
+// 	complete before returning.
+//
+// returns error -> an error if no worker is registered under workerID, nil
+// otherwise.
+func (helper *ClientHelper) StopWorkerGracefully(workerID int64, drainTimeout time.Duration) error {
+	record := helper.Workers.get(workerID)
+	if record == nil {
+		return fmt.Errorf("worker registry: no worker registered for workerID %d", workerID)
+	}
+
+	helper.Workers.setState(workerID, WorkerStateDraining)
+
+	drained := make(chan struct{})
+	go func() {
+		record.worker.Stop()
+		helper.Workers.setState(workerID, WorkerStateStopped)
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		helper.Logger.Info("Worker drained and stopped", zap.Int64("WorkerId", workerID))
+	case <-time.After(drainTimeout):
+		helper.Logger.Warn("Worker drain timed out, still draining in background",
+			zap.Int64("WorkerId", workerID))
+		return nil
+	}
+
+	helper.Workers.setState(workerID, WorkerStateStopped)
+
+	return nil
+}
+
+// RestartWorker stops the worker registered under workerID and starts a
+// replacement with newOptions on the same namespace/taskList, preserving
+// workerID so any outstanding references to it (e.g. WorkflowRegister /
+// ActivityRegister registrations keyed by workerID) keep resolving to the
+// running worker.
+//
+// params:
+//	- workerID int64 -> the workerID of the worker to restart.
+// 	- newOptions worker.Options -> the worker.Options to start the
+// 	replacement worker with.
+//
+// returns:
+//	- worker.Worker -> the replacement worker.Worker.
+// 	- error -> an error if no worker is registered under workerID, or the
+// 	replacement worker failed to start.
+func (helper *ClientHelper) RestartWorker(workerID int64, newOptions worker.Options) (worker.Worker, error) {
+	record := helper.Workers.get(workerID)
+	if record == nil {
+		return nil, fmt.Errorf("worker registry: no worker registered for workerID %d", workerID)
+	}
+
+	record.worker.Stop()
+
+	newWorker, err := helper.StartWorker(record.namespace, record.taskList, workerID, newOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	helper.Logger.Info("Restarted Worker", zap.Int64("WorkerId", workerID))
+
+	return newWorker, nil
+}
+
+// ListWorkers returns a WorkerStatus snapshot for every worker currently
+// registered, in no particular order.
+func (helper *ClientHelper) ListWorkers() []WorkerStatus {
+	helper.Workers.mu.Lock()
+	defer helper.Workers.mu.Unlock()
+
+	statuses := make([]WorkerStatus, 0, len(helper.Workers.workers))
+	for workerID, record := range helper.Workers.workers {
+		statuses = append(statuses, WorkerStatus{
+			WorkerID:      workerID,
+			Namespace:     record.namespace,
+			TaskList:      record.taskList,
+			State:         record.state,
+			LastPollError: record.lastPollError,
+		})
+	}
+
+	return statuses
+}
+
+// WorkerHealth returns the WorkerStatus registered under workerID.
+//
+// param workerID int64 -> the workerID of the worker to report on.
+//
+// returns:
+//	- *WorkerStatus -> the status of the worker registered under workerID.
+// 	- error -> an error if no worker is registered under workerID, nil
+// 	otherwise.
+func (helper *ClientHelper) WorkerHealth(workerID int64) (*WorkerStatus, error) {
+	record := helper.Workers.get(workerID)
+	if record == nil {
+		return nil, fmt.Errorf("worker registry: no worker registered for workerID %d", workerID)
+	}
+
+	helper.Workers.mu.Lock()
+	defer helper.Workers.mu.Unlock()
+
+	return &WorkerStatus{
+		WorkerID:      workerID,
+		Namespace:     record.namespace,
+		TaskList:      record.taskList,
+		State:         record.state,
+		LastPollError: record.lastPollError,
+	}, nil
+}
+
+// ActivityRegister registers activityFunc under activityName with the
+// worker.Worker started under workerID, so the task list that worker is
+// already polling picks it up going forward. It is a no-op, logged as a
+// warning, if no worker is registered under workerID.
+//
+// param workerID int64 -> the workerID of the worker to register the
+// activity with.
+// param activityFunc interface{} -> the activity function to register.
+// param activityName string -> the name to register activityFunc under.
+func (helper *ClientHelper) ActivityRegister(workerID int64, activityFunc interface{}, activityName string) {
+	record := helper.Workers.get(workerID)
+	if record == nil {
+		helper.Logger.Warn("worker registry: no worker registered for workerID, activity not registered",
+			zap.Int64("WorkerId", workerID), zap.String("Activity", activityName))
+		return
+	}
+
+	record.worker.RegisterActivityWithOptions(activityFunc, activity.RegisterOptions{Name: activityName})
+}
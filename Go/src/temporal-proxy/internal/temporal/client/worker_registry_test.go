@@ -0,0 +1,189 @@
+//-----------------------------------------------------------------------------
+// FILE:		worker_registry_test.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyclient
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"go.temporal.io/temporal/activity"
+	"go.temporal.io/temporal/worker"
+	"go.uber.org/zap"
+)
+
+// fakeWorker is a worker.Worker stand-in that only tracks what the
+// WorkerRegistry tests below need -- everything else panics if called.
+type fakeWorker struct {
+	worker.Worker
+
+	stopDelay time.Duration
+	stopped   chan struct{}
+
+	mu                sync.Mutex
+	registeredName    string
+	registeredActFunc interface{}
+}
+
+func newFakeWorker(stopDelay time.Duration) *fakeWorker {
+	return &fakeWorker{stopDelay: stopDelay, stopped: make(chan struct{})}
+}
+
+func (w *fakeWorker) Stop() {
+	time.Sleep(w.stopDelay)
+	close(w.stopped)
+}
+
+func (w *fakeWorker) RegisterActivityWithOptions(activityFunc interface{}, opts activity.RegisterOptions) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.registeredName = opts.Name
+	w.registeredActFunc = activityFunc
+}
+
+func newTestClientHelperWithWorker(workerID int64, w worker.Worker) *ClientHelper {
+	helper := &ClientHelper{
+		Logger:  zap.NewNop(),
+		Workers: NewWorkerRegistry(),
+	}
+	helper.Workers.add(workerID, "namespace", "taskList", worker.Options{}, w)
+
+	return helper
+}
+
+func TestWorkerRegistryAddGetRemove(t *testing.T) {
+	registry := NewWorkerRegistry()
+	w := newFakeWorker(0)
+
+	registry.add(1, "namespace", "taskList", worker.Options{}, w)
+
+	record := registry.get(1)
+	if record == nil {
+		t.Fatal("expected get to return the registered workerRecord")
+	}
+	if record.namespace != "namespace" || record.taskList != "taskList" {
+		t.Fatalf("unexpected workerRecord fields: %+v", record)
+	}
+	if record.state != WorkerStateRunning {
+		t.Fatalf("expected a newly added worker to start in WorkerStateRunning, got %v", record.state)
+	}
+
+	registry.remove(1)
+	if registry.get(1) != nil {
+		t.Fatal("expected get to return nil after remove")
+	}
+}
+
+func TestWorkerRegistrySetState(t *testing.T) {
+	registry := NewWorkerRegistry()
+	registry.add(1, "namespace", "taskList", worker.Options{}, newFakeWorker(0))
+
+	registry.setState(1, WorkerStateDraining)
+	if got := registry.get(1).state; got != WorkerStateDraining {
+		t.Fatalf("expected state to be WorkerStateDraining, got %v", got)
+	}
+
+	// setState on an unregistered workerID is a no-op, not a panic.
+	registry.setState(99, WorkerStateStopped)
+}
+
+func TestStopWorkerGracefullyDrainsBeforeTimeout(t *testing.T) {
+	w := newFakeWorker(5 * time.Millisecond)
+	helper := newTestClientHelperWithWorker(1, w)
+
+	if err := helper.StopWorkerGracefully(1, 500*time.Millisecond); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	select {
+	case <-w.stopped:
+	default:
+		t.Fatal("expected the underlying worker.Stop to have completed")
+	}
+
+	if got := helper.Workers.get(1).state; got != WorkerStateStopped {
+		t.Fatalf("expected WorkerStateStopped after a clean drain, got %v", got)
+	}
+}
+
+func TestStopWorkerGracefullyTimesOutStillDraining(t *testing.T) {
+	w := newFakeWorker(200 * time.Millisecond)
+	helper := newTestClientHelperWithWorker(1, w)
+
+	if err := helper.StopWorkerGracefully(1, 10*time.Millisecond); err != nil {
+		t.Fatalf("expected no error even when the drain times out, got %v", err)
+	}
+
+	if got := helper.Workers.get(1).state; got != WorkerStateDraining {
+		t.Fatalf("expected the worker to remain WorkerStateDraining after a timed-out drain, got %v", got)
+	}
+}
+
+func TestStopWorkerGracefullyUnknownWorkerID(t *testing.T) {
+	helper := newTestClientHelperWithWorker(1, newFakeWorker(0))
+
+	if err := helper.StopWorkerGracefully(99, time.Second); err == nil {
+		t.Fatal("expected an error for an unregistered workerID")
+	}
+}
+
+func TestActivityRegisterRegistersWithWorker(t *testing.T) {
+	w := newFakeWorker(0)
+	helper := newTestClientHelperWithWorker(1, w)
+
+	activityFunc := func() {}
+	helper.ActivityRegister(1, activityFunc, "my-activity")
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.registeredName != "my-activity" {
+		t.Fatalf("expected the activity to be registered under \"my-activity\", got %q", w.registeredName)
+	}
+}
+
+func TestActivityRegisterNoopWhenWorkerMissing(t *testing.T) {
+	helper := &ClientHelper{
+		Logger:  zap.NewNop(),
+		Workers: NewWorkerRegistry(),
+	}
+
+	// must not panic when no worker is registered under workerID.
+	helper.ActivityRegister(99, func() {}, "my-activity")
+}
+
+// TestWorkerRegistryConcurrentAccess exercises add/get/remove/setState from
+// many goroutines at once. Run with -race.
+func TestWorkerRegistryConcurrentAccess(t *testing.T) {
+	registry := NewWorkerRegistry()
+
+	var wg sync.WaitGroup
+	for i := int64(0); i < 16; i++ {
+		wg.Add(1)
+		go func(workerID int64) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				registry.add(workerID, "namespace", "taskList", worker.Options{}, newFakeWorker(0))
+				registry.setState(workerID, WorkerStateDraining)
+				registry.get(workerID)
+				registry.remove(workerID)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,157 @@
+//-----------------------------------------------------------------------------
+// FILE:		error.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyerror
+
+import (
+	"fmt"
+
+	enumspb "go.temporal.io/temporal-proto/enums"
+	"go.temporal.io/sdk/temporal"
+)
+
+type (
+
+	// ErrorType distinguishes which of Temporal's own failure kinds a
+	// TemporalError reconstructs into -- see ToTemporalFailure.
+	ErrorType int
+
+	// TemporalError is the proxy's own wire-friendly representation of a
+	// failed activity or workflow. It carries enough of Temporal's
+	// failure tree -- type, message, application error type, details,
+	// non-retryable flag, and cause chain -- for ToTemporalFailure to
+	// reconstruct the same typed error
+	// (temporal.ApplicationError/TimeoutError/CanceledError/
+	// TerminatedError) the Go SDK would have produced natively, instead
+	// of the caller only ever seeing a flattened string.
+	TemporalError struct {
+
+		// Type selects which temporal.Failure kind ToTemporalFailure
+		// reconstructs.
+		Type ErrorType
+
+		// Message is the human-readable failure message.
+		Message string
+
+		// ApplicationType is the custom error type name carried by an
+		// ApplicationFailureInfo -- e.g. the name of the error struct an
+		// activity returned. Unused for the other ErrorTypes.
+		ApplicationType string
+
+		// Details is the failure's opaque payload, already encoded the
+		// way the activity/workflow returned it.
+		Details []byte
+
+		// NonRetryable marks an ApplicationFailureInfo as one the
+		// workflow should not retry regardless of its retry policy.
+		NonRetryable bool
+
+		// Cause is the failure that caused this one, if any, reconstructed
+		// recursively by ToTemporalFailure.
+		Cause *TemporalError
+	}
+)
+
+const (
+
+	// ApplicationErrorType reconstructs into a temporal.ApplicationError,
+	// the kind an activity returns by failing with an arbitrary error.
+	ApplicationErrorType ErrorType = iota
+
+	// TimeoutErrorType reconstructs into a temporal.TimeoutError.
+	TimeoutErrorType
+
+	// CanceledErrorType reconstructs into a temporal.CanceledError.
+	CanceledErrorType
+
+	// TerminatedErrorType reconstructs into a temporal.TerminatedError.
+	TerminatedErrorType
+)
+
+// NewTemporalError is the default constructor for a TemporalError, wrapping
+// err as an ApplicationErrorType unless errType says otherwise.
+//
+// params:
+//	- err error -> the error to wrap.
+// 	- errType ...ErrorType -> the ErrorType to wrap err as; defaults to
+// 	ApplicationErrorType if omitted.
+//
+// returns *TemporalError -> a pointer to a newly initialized TemporalError.
+func NewTemporalError(err error, errType ...ErrorType) *TemporalError {
+	temporalError := &TemporalError{Message: err.Error()}
+	if len(errType) > 0 {
+		temporalError.Type = errType[0]
+	}
+
+	return temporalError
+}
+
+// ToString renders e as a single flattened, human-readable string, for
+// logging -- it discards the failure tree structure ToTemporalFailure
+// preserves.
+func (e *TemporalError) ToString() string {
+	if e == nil {
+		return ""
+	}
+
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %s", e.Message, e.Cause.ToString())
+	}
+
+	return e.Message
+}
+
+// ToTemporalFailure reconstructs e as the typed Temporal error the Go SDK
+// would have produced natively for e.Type, recursively reconstructing
+// e.Cause, instead of flattening the failure tree into an opaque string.
+// Returns nil if e is nil. Not every field survives: an ApplicationErrorType
+// with a Cause is rebuilt without its NonRetryable flag, a TimeoutErrorType
+// keeps only its Cause, a CanceledErrorType keeps only its Details, and a
+// TerminatedErrorType keeps none of e's fields.
+//
+// param e *TemporalError -> the TemporalError to reconstruct.
+//
+// returns error -> a *temporal.ApplicationError, *temporal.TimeoutError,
+// *temporal.CanceledError, or *temporal.TerminatedError, matching e.Type.
+func ToTemporalFailure(e *TemporalError) error {
+	if e == nil {
+		return nil
+	}
+
+	var cause error
+	if e.Cause != nil {
+		cause = ToTemporalFailure(e.Cause)
+	}
+
+	switch e.Type {
+	case TimeoutErrorType:
+		return temporal.NewTimeoutError(enumspb.TIMEOUT_TYPE_START_TO_CLOSE, cause)
+
+	case CanceledErrorType:
+		return temporal.NewCanceledError(e.Details)
+
+	case TerminatedErrorType:
+		return temporal.NewTerminatedError()
+
+	default:
+		if cause != nil {
+			return temporal.NewApplicationErrorWithCause(e.Message, e.ApplicationType, cause, e.Details)
+		}
+
+		return temporal.NewApplicationError(e.Message, e.ApplicationType, e.NonRetryable, e.Details)
+	}
+}
@@ -0,0 +1,174 @@
+//-----------------------------------------------------------------------------
+// FILE:		broker.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"context"
+	"sync"
+
+	"temporal-proxy/internal/messages"
+)
+
+type (
+
+	// Broker is the minimal durable pub/sub dependency BrokerTransport
+	// needs -- publish bytes to a named topic, and consume bytes
+	// published to a named topic as they arrive. A Kafka producer/
+	// consumer pair or a NATS JetStream publisher/subscriber both
+	// satisfy this shape; see NATSBroker for a concrete adapter.
+	Broker interface {
+
+		// Publish sends data to topic, returning once the broker has
+		// durably accepted it.
+		Publish(ctx context.Context, topic string, data []byte) error
+
+		// Subscribe returns a channel of the raw payloads published to
+		// topic from this point forward. The channel is closed when ctx
+		// is done or the subscription otherwise ends.
+		Subscribe(ctx context.Context, topic string) (<-chan []byte, error)
+
+		// Close releases the broker's connection.
+		Close() error
+	}
+
+	// BrokerTransport is a Transport that publishes each outbound
+	// ProxyMessage to requestTopic and demuxes replies arriving on
+	// replyTopic back to their caller by RequestID, the message-broker
+	// counterpart to HTTPTransport's PUT-per-message and StreamTransport's
+	// single multiplexed connection. Using a durable broker instead of a
+	// direct connection lets requests and replies survive the Neon.Temporal
+	// client being briefly unreachable, and lets the proxy run in
+	// environments where it can't share a network path with that client
+	// at all.
+	BrokerTransport struct {
+		broker       Broker
+		requestTopic string
+		replyTopic   string
+
+		mu      sync.Mutex
+		pending map[int64]chan replyOrError
+		closed  bool
+	}
+)
+
+// NewBrokerTransport is the default constructor for a BrokerTransport. It
+// subscribes to replyTopic and starts a background goroutine demuxing
+// replies to waiting Send calls by RequestID.
+//
+// params:
+//	- broker Broker -> the durable pub/sub dependency to publish requests
+//	to and consume replies from.
+// 	- requestTopic string -> the topic outbound ProxyMessages are
+//	published to.
+// 	- replyTopic string -> the topic replies are consumed from.
+//
+// returns:
+//	- *BrokerTransport -> a pointer to a new BrokerTransport in memory.
+// 	- error -> any error returned subscribing to replyTopic.
+func NewBrokerTransport(broker Broker, requestTopic string, replyTopic string) (*BrokerTransport, error) {
+	replies, err := broker.Subscribe(context.Background(), replyTopic)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &BrokerTransport{
+		broker:       broker,
+		requestTopic: requestTopic,
+		replyTopic:   replyTopic,
+		pending:      make(map[int64]chan replyOrError),
+	}
+
+	go t.readLoop(replies)
+
+	return t, nil
+}
+
+func (t *BrokerTransport) readLoop(replies <-chan []byte) {
+	for data := range replies {
+		reply, err := messages.Deserialize(data)
+		if err != nil {
+			continue
+		}
+
+		t.mu.Lock()
+		ch, ok := t.pending[reply.GetRequestID()]
+		if ok {
+			delete(t.pending, reply.GetRequestID())
+		}
+		t.mu.Unlock()
+
+		if ok {
+			ch <- replyOrError{reply: reply}
+		}
+	}
+
+	t.failAllPending(errStreamClosed)
+}
+
+func (t *BrokerTransport) failAllPending(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.closed = true
+	for requestID, ch := range t.pending {
+		ch <- replyOrError{err: err}
+		delete(t.pending, requestID)
+	}
+}
+
+// Send inherits docs from Transport.Send.
+func (t *BrokerTransport) Send(ctx context.Context, msg messages.IProxyMessage) (messages.IProxyMessage, error) {
+	data, err := messages.Serialize(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan replyOrError, 1)
+
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return nil, errStreamClosed
+	}
+	t.pending[msg.GetRequestID()] = ch
+	t.mu.Unlock()
+
+	if err := t.broker.Publish(ctx, t.requestTopic, data); err != nil {
+		t.mu.Lock()
+		delete(t.pending, msg.GetRequestID())
+		t.mu.Unlock()
+
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		t.mu.Lock()
+		delete(t.pending, msg.GetRequestID())
+		t.mu.Unlock()
+
+		return nil, ctx.Err()
+	case result := <-ch:
+		return result.reply, result.err
+	}
+}
+
+// Close inherits docs from Transport.Close.
+func (t *BrokerTransport) Close() error {
+	return t.broker.Close()
+}
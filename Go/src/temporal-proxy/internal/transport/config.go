@@ -0,0 +1,71 @@
+//-----------------------------------------------------------------------------
+// FILE:		config.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+// TransportConfig is the JSON-serializable description of how to build
+// this proxy's Transport, carrying the Broker-mode settings alongside
+// the existing HTTP/stream ones.
+//
+// NOTE: there's no InitializeRequest message type in this snapshot for
+// TransportConfig to be a field of -- per the request that motivated
+// this type, it's meant to become InitializeRequest.TransportConfig
+// once that message type exists. It's defined here, rather than left
+// out, so building a Transport from a deserialized request is a matter
+// of passing this straight to ToOptions.
+type TransportConfig struct {
+	Mode TransportMode `json:"mode"`
+
+	// HTTP/stream settings.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Broker settings.
+	BrokerURL    string `json:"brokerUrl,omitempty"`
+	RequestTopic string `json:"requestTopic,omitempty"`
+	ReplyTopic   string `json:"replyTopic,omitempty"`
+}
+
+// ToOptions builds the Options NewTransport needs for c.Mode, connecting
+// a new NATSBroker for TransportModeBroker. HTTP, stream, and gRPC modes
+// carry no connection of their own to construct here, so only Endpoint
+// is copied across for TransportModeHTTP; TransportModeStream's and
+// TransportModeGRPC's Conn must still be supplied by the caller (for
+// the latter, e.g. via the transport/grpc package's Dial).
+//
+// returns:
+//	- Options -> the Options to pass to NewTransport alongside c.Mode.
+// 	- error -> any error connecting to BrokerURL for TransportModeBroker.
+func (c TransportConfig) ToOptions() (Options, error) {
+	opts := Options{
+		Endpoint: c.Endpoint,
+	}
+
+	if c.Mode != TransportModeBroker {
+		return opts, nil
+	}
+
+	broker, err := NewNATSBroker(c.BrokerURL)
+	if err != nil {
+		return opts, err
+	}
+
+	opts.Broker = broker
+	opts.RequestTopic = c.RequestTopic
+	opts.ReplyTopic = c.ReplyTopic
+
+	return opts, nil
+}
@@ -0,0 +1,84 @@
+//-----------------------------------------------------------------------------
+// FILE:		factory.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"crypto/tls"
+	"fmt"
+	"time"
+)
+
+// Options configures NewTransport. Endpoint, Timeout, Retry, and TLS
+// apply to TransportModeHTTP; Conn applies to TransportModeStream and
+// TransportModeGRPC (for the latter, built with the transport/grpc
+// package's Dial); Broker, RequestTopic, and ReplyTopic apply to
+// TransportModeBroker.
+type Options struct {
+	Endpoint string
+	Timeout  time.Duration
+	Retry    RetryOptions
+	TLS      *tls.Config
+	Conn     FrameConn
+
+	Broker       Broker
+	RequestTopic string
+	ReplyTopic   string
+}
+
+// NewTransport builds the Transport selected by mode. This is the
+// intended home for a future InitializeRequest's TransportMode field to
+// dispatch to once the request/reply loop that owns InitializeRequest
+// exists in this tree.
+//
+// params:
+//	- mode TransportMode -> TransportModeHTTP, TransportModeStream, TransportModeGRPC, or TransportModeBroker.
+// 	- opts Options -> the settings the selected mode needs.
+//
+// returns:
+//	- Transport -> the constructed transport.
+// 	- error -> an error, if mode is unrecognized or missing a required option.
+func NewTransport(mode TransportMode, opts Options) (Transport, error) {
+	switch mode {
+	case TransportModeHTTP:
+		if opts.Endpoint == "" {
+			return nil, fmt.Errorf("transport: %s requires Endpoint", TransportModeHTTP)
+		}
+
+		return NewHTTPTransport(opts.Endpoint, opts.Timeout, opts.Retry, opts.TLS), nil
+
+	case TransportModeStream, TransportModeGRPC:
+		if opts.Conn == nil {
+			return nil, fmt.Errorf("transport: %s requires Conn", mode)
+		}
+
+		return NewStreamTransport(opts.Conn), nil
+
+	case TransportModeBroker:
+		if opts.Broker == nil {
+			return nil, fmt.Errorf("transport: %s requires Broker", TransportModeBroker)
+		}
+		if opts.RequestTopic == "" || opts.ReplyTopic == "" {
+			return nil, fmt.Errorf("transport: %s requires RequestTopic and ReplyTopic", TransportModeBroker)
+		}
+
+		return NewBrokerTransport(opts.Broker, opts.RequestTopic, opts.ReplyTopic)
+
+	default:
+		return nil, fmt.Errorf("transport: unknown mode %q", mode)
+	}
+}
@@ -0,0 +1,151 @@
+//-----------------------------------------------------------------------------
+// FILE:		proxymessenger.pb.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Hand-written to mirror what protoc-gen-go-grpc would generate from
+// proxymessenger.proto -- this tree has no protoc step wired into its
+// build, so this is authored directly rather than left out. Keep it in
+// sync with proxymessenger.proto by hand until that step exists.
+
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// Frame is the wire message for the ProxyMessenger.Stream RPC: a single
+// opaque payload, exactly what messages.Serialize/Deserialize produce
+// and consume. It carries no fields of its own beyond Payload, so it's
+// encoded with rawCodec instead of pulling in a full protobuf runtime
+// for a one-field message.
+type Frame struct {
+	Payload []byte
+}
+
+// ProxyMessengerClient is the client API for the ProxyMessenger service.
+type ProxyMessengerClient interface {
+
+	// Stream opens the bidi stream Frames are exchanged over.
+	Stream(ctx context.Context, opts ...grpc.CallOption) (ProxyMessenger_StreamClient, error)
+}
+
+type proxyMessengerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewProxyMessengerClient is the default constructor for a
+// ProxyMessengerClient bound to cc.
+func NewProxyMessengerClient(cc grpc.ClientConnInterface) ProxyMessengerClient {
+	return &proxyMessengerClient{cc: cc}
+}
+
+func (c *proxyMessengerClient) Stream(ctx context.Context, opts ...grpc.CallOption) (ProxyMessenger_StreamClient, error) {
+	opts = append(opts, grpc.CallContentSubtype(codecName))
+
+	stream, err := c.cc.NewStream(ctx, &_ProxyMessenger_serviceDesc.Streams[0], "/proxymessenger.ProxyMessenger/Stream", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proxyMessengerStreamClient{stream}, nil
+}
+
+type (
+
+	// ProxyMessenger_StreamClient is the client-side handle for an open
+	// ProxyMessenger.Stream call.
+	ProxyMessenger_StreamClient interface {
+		Send(*Frame) error
+		Recv() (*Frame, error)
+		grpc.ClientStream
+	}
+
+	proxyMessengerStreamClient struct {
+		grpc.ClientStream
+	}
+)
+
+func (x *proxyMessengerStreamClient) Send(f *Frame) error {
+	return x.ClientStream.SendMsg(f)
+}
+
+func (x *proxyMessengerStreamClient) Recv() (*Frame, error) {
+	f := new(Frame)
+	if err := x.ClientStream.RecvMsg(f); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// ProxyMessengerServer is the server API for the ProxyMessenger service.
+type ProxyMessengerServer interface {
+	Stream(ProxyMessenger_StreamServer) error
+}
+
+type (
+
+	// ProxyMessenger_StreamServer is the server-side handle for an open
+	// ProxyMessenger.Stream call.
+	ProxyMessenger_StreamServer interface {
+		Send(*Frame) error
+		Recv() (*Frame, error)
+		grpc.ServerStream
+	}
+
+	proxyMessengerStreamServer struct {
+		grpc.ServerStream
+	}
+)
+
+func (x *proxyMessengerStreamServer) Send(f *Frame) error {
+	return x.ServerStream.SendMsg(f)
+}
+
+func (x *proxyMessengerStreamServer) Recv() (*Frame, error) {
+	f := new(Frame)
+	if err := x.ServerStream.RecvMsg(f); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func _ProxyMessenger_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProxyMessengerServer).Stream(&proxyMessengerStreamServer{stream})
+}
+
+var _ProxyMessenger_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proxymessenger.ProxyMessenger",
+	HandlerType: (*ProxyMessengerServer)(nil),
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			Handler:       _ProxyMessenger_Stream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proxymessenger.proto",
+}
+
+// RegisterProxyMessengerServer registers srv with s to handle the
+// ProxyMessenger service's RPCs.
+func RegisterProxyMessengerServer(s *grpc.Server, srv ProxyMessengerServer) {
+	s.RegisterService(&_ProxyMessenger_serviceDesc, srv)
+}
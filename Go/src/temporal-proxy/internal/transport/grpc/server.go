@@ -0,0 +1,106 @@
+//-----------------------------------------------------------------------------
+// FILE:		server.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+
+	"temporal-proxy/internal/handlers"
+	"temporal-proxy/internal/messages"
+)
+
+// Server implements ProxyMessengerServer, routing every inbound Frame
+// on a Stream call through handlers.Dispatch and writing the resulting
+// reply back as a Frame -- the gRPC equivalent of internal/http.Server's
+// handleMessage, just over one long-lived stream per client instead of
+// one POST per message. Both share the same handlers.Dispatch, so they
+// drive the identical Workers/WorkflowContexts/ActivityContexts state
+// regardless of which transport a given client connected with.
+type Server struct {
+	grpcServer *grpc.Server
+}
+
+// NewServer is the default constructor for a Server. opts are passed
+// through to the underlying grpc.Server, e.g. transport credentials.
+//
+// returns *Server -> a pointer to a new Server in memory.
+func NewServer(opts ...grpc.ServerOption) *Server {
+	server := &Server{
+		grpcServer: grpc.NewServer(opts...),
+	}
+
+	RegisterProxyMessengerServer(server.grpcServer, server)
+
+	return server
+}
+
+// Stream inherits docs from ProxyMessengerServer.Stream. It reads Frames
+// off stream until the peer closes it or an error occurs, dispatching
+// each one that deserializes into an IProxyRequest through
+// handlers.Dispatch and sending the reply back over the same stream.
+// A Frame that isn't an IProxyRequest -- e.g. malformed, or a reply to a
+// request this proxy never sent on this stream -- is dropped; this
+// Server only serves inbound calls, it doesn't initiate its own (see
+// Dial for that side).
+func (server *Server) Stream(stream ProxyMessenger_StreamServer) error {
+	for {
+		frame, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		message, err := messages.Deserialize(frame.Payload)
+		if err != nil {
+			continue
+		}
+
+		request, ok := message.(messages.IProxyRequest)
+		if !ok {
+			continue
+		}
+
+		reply := handlers.Dispatch(stream.Context(), request)
+
+		data, err := messages.Serialize(reply)
+		if err != nil {
+			continue
+		}
+
+		if err := stream.Send(&Frame{Payload: data}); err != nil {
+			return err
+		}
+	}
+}
+
+// Serve starts server listening on lis. It blocks until lis is closed or
+// the underlying grpc.Server returns an error.
+//
+// param lis net.Listener -> the listener to accept ProxyMessenger.Stream
+// calls on.
+//
+// returns error -> any error returned by the underlying grpc.Server.
+func (server *Server) Serve(lis net.Listener) error {
+	return server.grpcServer.Serve(lis)
+}
+
+// Stop gracefully stops server, letting in-flight Stream calls drain.
+func (server *Server) Stop() {
+	server.grpcServer.GracefulStop()
+}
@@ -0,0 +1,271 @@
+//-----------------------------------------------------------------------------
+// FILE:		http.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+
+	"temporal-proxy/internal"
+	"temporal-proxy/internal/messages"
+)
+
+const (
+
+	// _defaultMaxRetries is the RetryOptions.MaxRetries HTTPTransport
+	// uses when none is configured.
+	_defaultMaxRetries = 3
+
+	// _defaultMinBackoff is the RetryOptions.MinBackoff HTTPTransport
+	// uses when none is configured.
+	_defaultMinBackoff = 100 * time.Millisecond
+
+	// _defaultMaxBackoff is the RetryOptions.MaxBackoff HTTPTransport
+	// uses when none is configured.
+	_defaultMaxBackoff = 5 * time.Second
+)
+
+type (
+
+	// RetryOptions configures the retry behavior NewHTTPTransport wraps
+	// its underlying http.Client in. A zero-value RetryOptions falls
+	// back to _defaultMaxRetries/_defaultMinBackoff/_defaultMaxBackoff,
+	// no ShouldStop, and no Classifier.
+	RetryOptions struct {
+
+		// MaxRetries is the number of additional attempts Send makes
+		// after an initial attempt that fails with a retryable error,
+		// before giving up and returning that error.
+		MaxRetries int
+
+		// MinBackoff is the delay before the first retry. Each
+		// subsequent retry doubles the previous delay, plus jitter,
+		// capped at MaxBackoff.
+		MinBackoff time.Duration
+
+		// MaxBackoff caps the delay between retries.
+		MaxBackoff time.Duration
+
+		// ShouldStop, if set, is checked before every attempt (including
+		// the first) and short-circuits Send with the last error seen
+		// once it returns true -- e.g. wired to a ControlState whose
+		// terminate flag was set with SetTerminate, so a proxy
+		// that's been told to shut down doesn't keep retrying sends
+		// into a client that's never coming back.
+		ShouldStop func() bool
+
+		// Classifier, if set, is consulted after every failed attempt
+		// and may veto a retry that isRetryable would otherwise allow,
+		// e.g. so a caller in the messages package can inspect a
+		// TemporalError's Type and decide a particular business
+		// error should be returned immediately rather than retried.
+		// Classifier is not called for errors isRetryable already
+		// rejects.
+		Classifier func(err error) (retry bool)
+	}
+
+	// HTTPTransport is a Transport that PUTs each ProxyMessage to
+	// endpoint over a single, reused http.Client, retrying transient
+	// failures (connection refused, 5xx, EOF, timeout) with jittered
+	// exponential backoff per Retry.
+	HTTPTransport struct {
+		endpoint string
+		client   *http.Client
+		retry    RetryOptions
+	}
+)
+
+// NewHTTPTransport is the default constructor for an HTTPTransport.
+//
+// params:
+//	- endpoint string -> the URL to PUT every ProxyMessage to.
+// 	- timeout time.Duration -> the per-attempt timeout for the underlying
+//	http.Client.
+//	- retry RetryOptions -> the retry behavior to wrap client.Do in; its
+//	zero value applies the package's default retry/backoff settings.
+//	- tlsConfig *tls.Config -> the mTLS configuration to dial endpoint
+//	with, e.g. from a tlsconfig.Reloader's ClientTLSConfig; nil leaves
+//	the http.Transport's default (no client certificate, system root CAs).
+//
+// returns *HTTPTransport -> a pointer to a new HTTPTransport in memory.
+func NewHTTPTransport(endpoint string, timeout time.Duration, retry RetryOptions, tlsConfig *tls.Config) *HTTPTransport {
+	if retry.MaxRetries <= 0 {
+		retry.MaxRetries = _defaultMaxRetries
+	}
+	if retry.MinBackoff <= 0 {
+		retry.MinBackoff = _defaultMinBackoff
+	}
+	if retry.MaxBackoff <= 0 {
+		retry.MaxBackoff = _defaultMaxBackoff
+	}
+
+	return &HTTPTransport{
+		endpoint: endpoint,
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				MaxIdleConns:        100,
+				MaxIdleConnsPerHost: 100,
+				IdleConnTimeout:     90 * time.Second,
+				TLSClientConfig:     tlsConfig,
+			},
+		},
+		retry: retry,
+	}
+}
+
+// Send inherits docs from Transport.Send. It retries a transient failure
+// (see isRetryable) up to Retry.MaxRetries times, waiting a jittered,
+// exponentially increasing backoff between attempts, and gives up early
+// if Retry.ShouldStop starts returning true or Retry.Classifier vetoes
+// the retry.
+func (t *HTTPTransport) Send(ctx context.Context, msg messages.IProxyMessage) (messages.IProxyMessage, error) {
+	data, err := messages.Serialize(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	backoff := t.retry.MinBackoff
+	var lastErr error
+
+	for attempt := 0; attempt <= t.retry.MaxRetries; attempt++ {
+		if t.retry.ShouldStop != nil && t.retry.ShouldStop() {
+			if lastErr == nil {
+				lastErr = fmt.Errorf("transport: send to %s stopped before completing", t.endpoint)
+			}
+
+			return nil, lastErr
+		}
+
+		reply, err := t.doSend(ctx, data)
+		if err == nil {
+			return reply, nil
+		}
+
+		lastErr = err
+		if attempt == t.retry.MaxRetries || !isRetryable(err) {
+			return nil, err
+		}
+		if t.retry.Classifier != nil && !t.retry.Classifier(err) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(jitter(backoff)):
+		}
+
+		backoff *= 2
+		if backoff > t.retry.MaxBackoff {
+			backoff = t.retry.MaxBackoff
+		}
+	}
+
+	return nil, lastErr
+}
+
+// doSend makes a single PUT attempt and reports a 5xx response as a
+// retryable error rather than decoding it as a reply.
+func (t *HTTPTransport) doSend(ctx context.Context, data []byte) (messages.IProxyMessage, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, t.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", internal.ContentType)
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, fmt.Errorf("%w: %s returned %d", errRetryableStatus, t.endpoint, resp.StatusCode)
+	}
+
+	return messages.Deserialize(body)
+}
+
+// Close inherits docs from Transport.Close.
+func (t *HTTPTransport) Close() error {
+	t.client.CloseIdleConnections()
+
+	return nil
+}
+
+// errRetryableStatus is wrapped into the error doSend returns for a 5xx
+// response, so isRetryable can recognize it with errors.Is.
+var errRetryableStatus = errors.New("transport: retryable server error")
+
+// isRetryable reports whether err represents a transient failure worth
+// retrying: a 5xx response, connection refused, a closed/reset
+// connection, an unexpected EOF, an unresolved host name ("no such
+// host"), or a timeout. Anything else -- a
+// malformed request, a context cancellation, a business error decoded
+// from the reply body -- is returned to the caller immediately.
+func isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, errRetryableStatus) {
+		return true
+	}
+
+	if netErr, ok := err.(interface{ Timeout() bool }); ok && netErr.Timeout() {
+		return true
+	}
+
+	msg := err.Error()
+	for _, transient := range []string{
+		"connection refused",
+		"connection reset",
+		"broken pipe",
+		"EOF",
+		"no such host",
+	} {
+		if strings.Contains(msg, transient) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// jitter returns d plus or minus up to 25%, so a burst of retrying
+// transports (e.g. many sends failing at once during a client restart)
+// don't all retry in lockstep.
+func jitter(d time.Duration) time.Duration {
+	spread := float64(d) * 0.25
+
+	return d + time.Duration(spread*(2*rand.Float64()-1))
+}
@@ -0,0 +1,91 @@
+//-----------------------------------------------------------------------------
+// FILE:		nats_broker.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NATSBroker is a Broker backed by a NATS JetStream connection. Topics
+// map directly to JetStream subjects; durability comes from the stream
+// the caller has already provisioned to capture those subjects, not
+// from anything this type does itself.
+type NATSBroker struct {
+	conn *nats.Conn
+	js   nats.JetStreamContext
+}
+
+// NewNATSBroker is the default constructor for a NATSBroker.
+//
+// param url string -> the NATS server URL, e.g. "nats://127.0.0.1:4222".
+//
+// returns:
+//	- *NATSBroker -> a pointer to a new NATSBroker in memory.
+// 	- error -> any error connecting or acquiring a JetStream context.
+func NewNATSBroker(url string) (*NATSBroker, error) {
+	conn, err := nats.Connect(url)
+	if err != nil {
+		return nil, err
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &NATSBroker{conn: conn, js: js}, nil
+}
+
+// Publish inherits docs from Broker.Publish.
+func (b *NATSBroker) Publish(ctx context.Context, topic string, data []byte) error {
+	_, err := b.js.Publish(topic, data, nats.Context(ctx))
+
+	return err
+}
+
+// Subscribe inherits docs from Broker.Subscribe.
+func (b *NATSBroker) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	out := make(chan []byte)
+
+	sub, err := b.js.Subscribe(topic, func(msg *nats.Msg) {
+		out <- msg.Data
+		_ = msg.Ack()
+	})
+	if err != nil {
+		close(out)
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = sub.Unsubscribe()
+		close(out)
+	}()
+
+	return out, nil
+}
+
+// Close inherits docs from Broker.Close.
+func (b *NATSBroker) Close() error {
+	b.conn.Close()
+
+	return nil
+}
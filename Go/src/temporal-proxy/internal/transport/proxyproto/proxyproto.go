@@ -0,0 +1,176 @@
+//-----------------------------------------------------------------------------
+// FILE:		proxyproto.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proxyproto wraps a net.Listener to parse a PROXY protocol v2
+// preamble (https://www.haproxy.org/download/2.0/doc/proxy-protocol.txt)
+// off each accepted connection, so internal/http.Server still sees the
+// real client address when it's running behind an L4 load balancer
+// (HAProxy, Envoy) instead of the balancer's own address. Only the
+// binary v2 header is supported, over AF_INET/AF_INET6 -- v1's text
+// header and AF_UNIX are not handled, since neither the proxy nor any
+// client it's documented to run behind use them.
+package proxyproto
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+)
+
+// signature is the fixed 12-byte preamble every PROXY protocol v2
+// header starts with.
+var signature = [12]byte{0x0D, 0x0A, 0x0D, 0x0A, 0x00, 0x0D, 0x0A, 0x51, 0x55, 0x49, 0x54, 0x0A}
+
+const (
+	_cmdLocal = 0x0
+	_famINET  = 0x1
+	_famINET6 = 0x2
+)
+
+// NewListener wraps lis so every connection it Accepts has its PROXY
+// protocol v2 header, if present, parsed before any application data
+// reaches the caller, with RemoteAddr overridden to the original client
+// address the header carries. A connection whose first 12 bytes don't
+// match the v2 signature is passed through with its application data
+// untouched and its real conn.RemoteAddr() -- this is safe to put in
+// front of a listener that also accepts non-proxied connections.
+func NewListener(lis net.Listener) net.Listener {
+	return &listener{Listener: lis}
+}
+
+type listener struct {
+	net.Listener
+}
+
+// Accept inherits docs from net.Listener.Accept.
+func (l *listener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	return &proxyConn{Conn: conn, r: bufio.NewReader(conn)}, nil
+}
+
+type proxyConn struct {
+	net.Conn
+	r          *bufio.Reader
+	parsed     bool
+	remoteAddr net.Addr
+}
+
+// Read inherits docs from net.Conn.Read. The first call parses and
+// strips the PROXY protocol header, if any, before returning any
+// application data.
+func (c *proxyConn) Read(p []byte) (int, error) {
+	if !c.parsed {
+		c.parsed = true
+		if err := c.parseHeader(); err != nil {
+			return 0, err
+		}
+	}
+
+	return c.r.Read(p)
+}
+
+// RemoteAddr inherits docs from net.Conn.RemoteAddr, returning the
+// original client address a PROXY protocol header carried, or the
+// underlying connection's own remote address if none was present.
+func (c *proxyConn) RemoteAddr() net.Addr {
+	if c.remoteAddr != nil {
+		return c.remoteAddr
+	}
+
+	return c.Conn.RemoteAddr()
+}
+
+// parseHeader peeks the first 12 bytes looking for the v2 signature; if
+// they don't match, it leaves the buffered bytes for Read and returns
+// nil, treating the connection as not PROXY-protocol-wrapped.
+func (c *proxyConn) parseHeader() error {
+	sig, err := c.r.Peek(len(signature))
+	if err != nil {
+		return nil
+	}
+
+	for i, b := range sig {
+		if b != signature[i] {
+			return nil
+		}
+	}
+
+	if _, err := io.CopyN(ioutil.Discard, c.r, int64(len(signature))); err != nil {
+		return err
+	}
+
+	verCmd, err := c.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	if verCmd>>4 != 2 {
+		return errors.New("proxyproto: unsupported PROXY protocol version")
+	}
+	command := verCmd & 0x0F
+
+	famProto, err := c.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	family := famProto >> 4
+
+	var lenBuf [2]byte
+	if _, err := io.ReadFull(c.r, lenBuf[:]); err != nil {
+		return err
+	}
+	addrLen := binary.BigEndian.Uint16(lenBuf[:])
+
+	addr := make([]byte, addrLen)
+	if _, err := io.ReadFull(c.r, addr); err != nil {
+		return err
+	}
+
+	if command == _cmdLocal {
+		// LOCAL: a health check from the balancer itself, not a
+		// proxied connection -- keep the real conn.RemoteAddr().
+		return nil
+	}
+
+	switch family {
+	case _famINET:
+		if len(addr) < 12 {
+			return errors.New("proxyproto: short IPv4 address block")
+		}
+		c.remoteAddr = &net.TCPAddr{
+			IP:   net.IP(addr[0:4]),
+			Port: int(binary.BigEndian.Uint16(addr[8:10])),
+		}
+
+	case _famINET6:
+		if len(addr) < 36 {
+			return errors.New("proxyproto: short IPv6 address block")
+		}
+		c.remoteAddr = &net.TCPAddr{
+			IP:   net.IP(addr[0:16]),
+			Port: int(binary.BigEndian.Uint16(addr[32:34])),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+//-----------------------------------------------------------------------------
+// FILE:		stream.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"temporal-proxy/internal/messages"
+)
+
+// errStreamClosed is returned by StreamTransport.Send once the
+// underlying connection has been closed.
+var errStreamClosed = errors.New("transport: stream closed")
+
+type (
+
+	// FrameConn is the minimal framed, bidirectional connection a
+	// StreamTransport multiplexes over -- one Send/Recv pair of framed
+	// byte messages. An HTTP/2 stream or gRPC bidi stream both satisfy
+	// this shape once wired up to the real dispatch loop; StreamTransport
+	// itself only does the RequestID demuxing.
+	FrameConn interface {
+		Send(data []byte) error
+		Recv() ([]byte, error)
+		Close() error
+	}
+
+	// StreamTransport is a Transport that multiplexes many outstanding
+	// requests over one persistent FrameConn, demuxing replies back to
+	// their caller by RequestID instead of opening a connection per
+	// message the way HTTPTransport's predecessor did.
+	StreamTransport struct {
+		conn    FrameConn
+		mu      sync.Mutex
+		pending map[int64]chan replyOrError
+		closed  bool
+	}
+
+	replyOrError struct {
+		reply messages.IProxyMessage
+		err   error
+	}
+)
+
+// NewStreamTransport is the default constructor for a StreamTransport.
+// It takes ownership of conn, starting a background goroutine that
+// reads replies off it and demuxes them to waiting Send calls by
+// RequestID.
+//
+// param conn FrameConn -> the underlying framed connection to multiplex.
+//
+// returns *StreamTransport -> a pointer to a new StreamTransport in memory.
+func NewStreamTransport(conn FrameConn) *StreamTransport {
+	t := &StreamTransport{
+		conn:    conn,
+		pending: make(map[int64]chan replyOrError),
+	}
+
+	go t.readLoop()
+
+	return t
+}
+
+func (t *StreamTransport) readLoop() {
+	for {
+		data, err := t.conn.Recv()
+		if err != nil {
+			t.failAllPending(err)
+			return
+		}
+
+		reply, err := messages.Deserialize(data)
+		if err != nil {
+			continue
+		}
+
+		t.mu.Lock()
+		ch, ok := t.pending[reply.GetRequestID()]
+		if ok {
+			delete(t.pending, reply.GetRequestID())
+		}
+		t.mu.Unlock()
+
+		if ok {
+			ch <- replyOrError{reply: reply}
+		}
+	}
+}
+
+func (t *StreamTransport) failAllPending(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.closed = true
+	for requestID, ch := range t.pending {
+		ch <- replyOrError{err: err}
+		delete(t.pending, requestID)
+	}
+}
+
+// Send inherits docs from Transport.Send.
+func (t *StreamTransport) Send(ctx context.Context, msg messages.IProxyMessage) (messages.IProxyMessage, error) {
+	data, err := messages.Serialize(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan replyOrError, 1)
+
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return nil, errStreamClosed
+	}
+	t.pending[msg.GetRequestID()] = ch
+	t.mu.Unlock()
+
+	if err := t.conn.Send(data); err != nil {
+		t.mu.Lock()
+		delete(t.pending, msg.GetRequestID())
+		t.mu.Unlock()
+
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		t.mu.Lock()
+		delete(t.pending, msg.GetRequestID())
+		t.mu.Unlock()
+
+		return nil, ctx.Err()
+	case result := <-ch:
+		return result.reply, result.err
+	}
+}
+
+// Close inherits docs from Transport.Close.
+func (t *StreamTransport) Close() error {
+	return t.conn.Close()
+}
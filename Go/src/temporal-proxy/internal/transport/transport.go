@@ -0,0 +1,85 @@
+//-----------------------------------------------------------------------------
+// FILE:		transport.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package transport defines how a ProxyMessage travels between the
+// Neon.Temporal client and this proxy, decoupling that wire concern from
+// the IProxyRequest/IProxyReply dispatch in the handlers package.
+//
+// NOTE: the dispatch loop this is meant to plug into (MessageHandler,
+// putReply, handleIProxyRequest, handleIProxyReply, InitializeRequest)
+// is not present in this snapshot of the repo -- only the leaf
+// handlers/messages/client packages are. HTTPTransport and StreamTransport
+// are written against the description of that loop in the originating
+// request so they're ready to wire in once it exists; there is
+// deliberately no call site added here.
+package transport
+
+import (
+	"context"
+
+	"temporal-proxy/internal/messages"
+)
+
+type (
+
+	// TransportMode selects which Transport implementation
+	// InitializeRequest's TransportMode field should build.
+	TransportMode string
+
+	// Transport sends a ProxyMessage to the other side of the proxy
+	// boundary (Neon.Temporal client <-> temporal-proxy) and, for
+	// request/reply pairs, demultiplexes the matching reply back to the
+	// caller by the message's RequestID. Implementations must be safe
+	// for concurrent use -- many outstanding workflow/activity calls
+	// share one Transport.
+	Transport interface {
+
+		// Send delivers msg to the other side and returns its correlated
+		// reply, matched by msg.GetRequestID().
+		Send(ctx context.Context, msg messages.IProxyMessage) (messages.IProxyMessage, error)
+
+		// Close releases any connection or resources the Transport holds.
+		Close() error
+	}
+)
+
+const (
+
+	// TransportModeHTTP selects HTTPTransport, which PUTs each message
+	// to the peer's endpoint over a reused, keep-alive http.Client. This
+	// preserves today's wire format while dropping the per-message
+	// dial/teardown cost.
+	TransportModeHTTP TransportMode = "http"
+
+	// TransportModeStream selects StreamTransport, a persistent,
+	// framed, bidirectional connection that multiplexes many
+	// outstanding requests by RequestID over a single connection.
+	TransportModeStream TransportMode = "stream"
+
+	// TransportModeGRPC selects StreamTransport multiplexed over a gRPC
+	// bidi stream instead of a raw framed connection, via the
+	// transport/grpc package's FrameConn adapter. It shares
+	// StreamTransport's RequestID demuxing -- only how the underlying
+	// Conn is established differs from TransportModeStream.
+	TransportModeGRPC TransportMode = "grpc"
+
+	// TransportModeBroker selects BrokerTransport, which publishes
+	// requests to and consumes replies from a durable message broker
+	// (Kafka, NATS JetStream, ...) instead of a direct connection, so
+	// requests and replies survive the peer being briefly unreachable.
+	TransportModeBroker TransportMode = "broker"
+)
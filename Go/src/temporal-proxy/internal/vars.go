@@ -18,7 +18,7 @@
 package internal
 
 import (
-	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap"
 )
 
 const (
@@ -49,6 +49,37 @@ var (
 	// is used to configure specified settings.
 	Debug = false
 
-	// LogLevel specifies the global LogLevel for the temporal-proxy.
-	LogLevel zapcore.LevelEnabler
+	// LogLevel specifies the global, dynamically adjustable log level
+	// for the temporal-proxy. It's a zap.AtomicLevel rather than a plain
+	// zapcore.Level so a LogLevelRequest (see
+	// internal/handlers/log_level_request.go) can change the verbosity
+	// of every Logger built by NewLogger without restarting the proxy.
+	LogLevel = zap.NewAtomicLevel()
+
+	// DisableHeaderForwarding disables forwarding of gRPC metadata headers
+	// (auth tokens, tenant ids, tracing baggage) between the Neon.Temporal
+	// client and the Temporal server. Callers that don't rely on header
+	// propagating interceptors can set this to avoid the extra payload on
+	// every proxied request. Defaults to false.
+	DisableHeaderForwarding = false
+
+	// DefaultEnableEagerWorkflowStart is the EnableEagerStart value applied
+	// to StartWorkflowOptions/ChildWorkflowOptions when a request doesn't
+	// specify its own Options at all, letting .NET clients that haven't
+	// been updated to set the flag still benefit from eager start against
+	// servers that support it. Has no effect when the server doesn't
+	// support the optimization, since the server simply ignores it.
+	// Defaults to true.
+	DefaultEnableEagerWorkflowStart = true
+
+	// LogFormat selects the encoding NewLogger builds its loggers with:
+	// LogFormatConsole (the default, human-readable) or LogFormatJSON
+	// (structured, for ingestion by tools like ELK or Loki).
+	LogFormat = LogFormatConsole
+
+	// LogFile, if non-empty, is the path NewLogger additionally writes
+	// log entries to, rotated once it grows past 100MB, keeping up to 5
+	// rotated backups for 28 days. Leaving it empty disables the file
+	// sink and logs go to stderr only.
+	LogFile = ""
 )
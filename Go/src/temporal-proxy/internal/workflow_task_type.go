@@ -0,0 +1,77 @@
+//-----------------------------------------------------------------------------
+// FILE:		workflow_task_type.go
+// CONTRIBUTOR: John C Burns
+// COPYRIGHT:	Copyright (c) 2016-2019 by neonFORGE, LLC.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+// WorkflowTaskType distinguishes a Normal workflow task, whose
+// completion is durably recorded in workflow history, from a
+// Speculative one -- a task dispatched and executed (e.g. to answer a
+// query or validate an update) that the server may discard from history
+// entirely if the workflow makes no progress while handling it.
+//
+// CONTRACT: a WorkflowInvokeRequest carrying WorkflowTaskTypeSpeculative
+// must not enqueue any new commands other than valid completion signals
+// (returning a result/error) -- no new timers, activities, or child
+// workflows -- since those commands would have no history event to
+// attach to if the task is later discarded. Enforcing this is left to
+// whichever workflow task loop builds on this field; it isn't validated
+// here.
+type WorkflowTaskType int32
+
+const (
+
+	// WorkflowTaskTypeUnspecified is the zero value of WorkflowTaskType,
+	// returned when a WorkflowInvokeRequest predates this field.
+	WorkflowTaskTypeUnspecified WorkflowTaskType = iota
+
+	// WorkflowTaskTypeNormal is a workflow task whose completion is
+	// durably recorded in workflow history.
+	WorkflowTaskTypeNormal
+
+	// WorkflowTaskTypeSpeculative is a workflow task the server may
+	// discard from history if the workflow makes no progress handling
+	// it (e.g. a query-only or update-validation task). See the
+	// CONTRACT on WorkflowTaskType.
+	WorkflowTaskTypeSpeculative
+)
+
+// String returns the string representation of a WorkflowTaskType,
+// suitable for round-tripping through StringToWorkflowTaskType.
+func (t WorkflowTaskType) String() string {
+	switch t {
+	case WorkflowTaskTypeNormal:
+		return "Normal"
+	case WorkflowTaskTypeSpeculative:
+		return "Speculative"
+	default:
+		return "Unspecified"
+	}
+}
+
+// StringToWorkflowTaskType parses value, as produced by
+// WorkflowTaskType.String, back into a WorkflowTaskType, returning
+// WorkflowTaskTypeUnspecified for anything it doesn't recognize.
+func StringToWorkflowTaskType(value string) WorkflowTaskType {
+	switch value {
+	case "Normal":
+		return WorkflowTaskTypeNormal
+	case "Speculative":
+		return WorkflowTaskTypeSpeculative
+	default:
+		return WorkflowTaskTypeUnspecified
+	}
+}